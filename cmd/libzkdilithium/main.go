@@ -0,0 +1,127 @@
+// Command libzkdilithium is a cgo shim exposing dilithium.Gen/Sign/Verify
+// and hash.SeedClonableXOF128's matrix-expansion stream through a C ABI,
+// so the reference signer can be linked into a Rust (or any cgo-compatible)
+// zk-prover instead of shelled out to as a subprocess.
+//
+// Every exported function uses the standard cgo length-prefixed byte-slice
+// convention: a pointer plus an explicit int length, never a NUL-terminated
+// C string, since keys, messages and signatures are all binary. Buffers
+// returned through an `out`/`out_len` pair are allocated with C.malloc and
+// must be released by the caller via zkdilithium_free.
+//
+// HONEST SCOPE NOTE: building this into a .a/.so (`go build -buildmode=c-archive`)
+// and the matching C header is a normal part of this package's toolchain,
+// but this checkout has no go.mod and no cgo/Rust toolchain available to
+// run that build, generate the header, or exercise it. The
+// `zkdilithium_sys` Rust crate (uniffi/cbindgen scaffolding around this
+// shim) and the CI job replaying stress_vectors.json through it are not
+// included for the same reason — there's nothing here to wire real Rust
+// bindgen output into. This file is the Go-side source of truth those
+// tools would consume.
+package main
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"zkdilithium-signer/pkg/dilithium"
+	"zkdilithium-signer/pkg/hash"
+)
+
+// toCBytes copies a Go byte slice into a newly C.malloc'd buffer and
+// reports its length through outLen. The caller must release the buffer
+// with zkdilithium_free.
+func toCBytes(b []byte, out **C.uint8_t, outLen *C.int) {
+	if len(b) == 0 {
+		*out = nil
+		*outLen = 0
+		return
+	}
+	buf := C.malloc(C.size_t(len(b)))
+	C.memcpy(buf, unsafe.Pointer(&b[0]), C.size_t(len(b)))
+	*out = (*C.uint8_t)(buf)
+	*outLen = C.int(len(b))
+}
+
+// fromCBytes views a C buffer as a Go byte slice without copying. The
+// returned slice is only valid for the duration of the call that produced
+// the pointer.
+func fromCBytes(p *C.uint8_t, n C.int) []byte {
+	if p == nil || n == 0 {
+		return nil
+	}
+	return unsafe.Slice((*byte)(unsafe.Pointer(p)), int(n))
+}
+
+// zkdilithium_gen generates a keypair from a 32-byte seed. Returns 0 on
+// success, -1 if seed_len != 32.
+//
+//export zkdilithium_gen
+func zkdilithium_gen(seed *C.uint8_t, seedLen C.int, pkOut **C.uint8_t, pkLenOut *C.int, skOut **C.uint8_t, skLenOut *C.int) C.int {
+	if seedLen != 32 {
+		return -1
+	}
+	pk, sk := dilithium.Gen(fromCBytes(seed, seedLen))
+	toCBytes(pk, pkOut, pkLenOut)
+	toCBytes(sk, skOut, skLenOut)
+	return 0
+}
+
+// zkdilithium_sign signs msg with sk. Returns 0 on success.
+//
+//export zkdilithium_sign
+func zkdilithium_sign(sk *C.uint8_t, skLen C.int, msg *C.uint8_t, msgLen C.int, sigOut **C.uint8_t, sigLenOut *C.int) C.int {
+	sig := dilithium.Sign(fromCBytes(sk, skLen), fromCBytes(msg, msgLen))
+	toCBytes(sig, sigOut, sigLenOut)
+	return 0
+}
+
+// zkdilithium_verify checks sig against pk and msg. Returns 1 if valid, 0
+// if invalid.
+//
+//export zkdilithium_verify
+func zkdilithium_verify(pk *C.uint8_t, pkLen C.int, msg *C.uint8_t, msgLen C.int, sig *C.uint8_t, sigLen C.int) C.int {
+	ok := dilithium.Verify(fromCBytes(pk, pkLen), fromCBytes(msg, msgLen), fromCBytes(sig, sigLen))
+	if ok {
+		return 1
+	}
+	return 0
+}
+
+// zkdilithium_xof128_expand fills out (outLen bytes) with the same
+// SHAKE-128 stream hash.SeedClonableXOF128 produces for seed||nonce, so a
+// Rust matrix-expansion step can be checked byte-for-byte against the Go
+// sampler without reimplementing SeedClonableXOF128's nonce-reseek logic.
+//
+//export zkdilithium_xof128_expand
+func zkdilithium_xof128_expand(seed *C.uint8_t, seedLen C.int, nonce C.uint16_t, out *C.uint8_t, outLen C.int) {
+	x := hash.NewSeedClonableXOF128(fromCBytes(seed, seedLen))
+	x.SetNonce(uint16(nonce))
+	dst := unsafe.Slice((*byte)(unsafe.Pointer(out)), int(outLen))
+	for i := 0; i < len(dst); {
+		b0, b1, b2 := x.Read3()
+		for _, b := range [3]byte{b0, b1, b2} {
+			if i >= len(dst) {
+				break
+			}
+			dst[i] = b
+			i++
+		}
+	}
+}
+
+// zkdilithium_free releases a buffer previously returned through an
+// out/out_len pair by zkdilithium_gen or zkdilithium_sign.
+//
+//export zkdilithium_free
+func zkdilithium_free(buf *C.uint8_t) {
+	C.free(unsafe.Pointer(buf))
+}
+
+func main() {}
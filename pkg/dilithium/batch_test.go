@@ -0,0 +1,196 @@
+package dilithium
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestSignBatchMatchesSign checks SignBatch produces the same signatures
+// SignContext would produce message-by-message, and that VerifyBatch
+// accepts them all.
+func TestSignBatchMatchesSign(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	msgs := [][]byte{
+		[]byte("first message"),
+		[]byte("second message"),
+		[]byte("third message"),
+	}
+
+	sigs := SignBatch(sk, msgs)
+	if len(sigs) != len(msgs) {
+		t.Fatalf("SignBatch returned %d signatures, want %d", len(sigs), len(msgs))
+	}
+
+	for i, msg := range msgs {
+		if !bytes.Equal(sigs[i], SignContext(sk, msg, nil)) {
+			t.Errorf("message %d: SignBatch signature differs from SignContext", i)
+		}
+		if !Verify(pk, msg, sigs[i]) {
+			t.Errorf("message %d: SignBatch signature does not verify", i)
+		}
+	}
+
+	oks := VerifyBatch(pk, msgs, sigs)
+	if len(oks) != len(msgs) {
+		t.Fatalf("VerifyBatch returned %d results, want %d", len(oks), len(msgs))
+	}
+	for i, ok := range oks {
+		if !ok {
+			t.Errorf("message %d: VerifyBatch rejected a valid signature", i)
+		}
+	}
+}
+
+// TestVerifyBatchRejectsTamperedMessage checks VerifyBatch catches a
+// mismatched (msg, sig) pair among otherwise-valid ones.
+func TestVerifyBatchRejectsTamperedMessage(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	msgs := [][]byte{[]byte("valid message"), []byte("also valid")}
+	sigs := SignBatch(sk, msgs)
+
+	tampered := append([][]byte{}, msgs...)
+	tampered[1] = []byte("not the signed message")
+
+	oks := VerifyBatch(pk, tampered, sigs)
+	if !oks[0] {
+		t.Error("message 0: expected valid signature to verify")
+	}
+	if oks[1] {
+		t.Error("message 1: expected tampered message to fail verification")
+	}
+}
+
+// TestSignBatchEmpty checks SignBatch handles an empty batch.
+func TestSignBatchEmpty(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	sigs := SignBatch(sk, nil)
+	if len(sigs) != 0 {
+		t.Errorf("SignBatch(nil) returned %d signatures, want 0", len(sigs))
+	}
+}
+
+// TestVerifyBatchMultiMixedKeys checks VerifyBatchMulti against a batch
+// spanning two distinct keys, each signing several messages, and asserts
+// allOK/perSig agree with verifying each triple through Verify.
+func TestVerifyBatchMultiMixedKeys(t *testing.T) {
+	pkA, skA := Gen(make([]byte, 32))
+	pkB, skB := Gen(bytes.Repeat([]byte{1}, 32))
+
+	msgsA := [][]byte{[]byte("a message one"), []byte("a message two")}
+	msgsB := [][]byte{[]byte("b message one"), []byte("b message two"), []byte("b message three")}
+	sigsA := SignBatch(skA, msgsA)
+	sigsB := SignBatch(skB, msgsB)
+
+	var pks, msgs, sigs [][]byte
+	for i := range msgsA {
+		pks = append(pks, pkA)
+		msgs = append(msgs, msgsA[i])
+		sigs = append(sigs, sigsA[i])
+	}
+	for i := range msgsB {
+		pks = append(pks, pkB)
+		msgs = append(msgs, msgsB[i])
+		sigs = append(sigs, sigsB[i])
+	}
+
+	allOK, perSig := VerifyBatchMulti(pks, msgs, sigs)
+	if !allOK {
+		t.Errorf("VerifyBatchMulti: allOK = false, want true")
+	}
+	if len(perSig) != len(pks) {
+		t.Fatalf("VerifyBatchMulti returned %d results, want %d", len(perSig), len(pks))
+	}
+	for i, ok := range perSig {
+		if !ok {
+			t.Errorf("triple %d: VerifyBatchMulti rejected a valid signature", i)
+		}
+	}
+}
+
+// TestVerifyBatchMultiCatchesWrongKey checks VerifyBatchMulti flags a
+// signature paired with the wrong public key while leaving the rest
+// unaffected, and that allOK reflects the failure.
+func TestVerifyBatchMultiCatchesWrongKey(t *testing.T) {
+	pkA, skA := Gen(make([]byte, 32))
+	pkB, _ := Gen(bytes.Repeat([]byte{1}, 32))
+
+	msg := []byte("shared message")
+	sig := Sign(skA, msg)
+
+	pks := [][]byte{pkA, pkB}
+	msgs := [][]byte{msg, msg}
+	sigs := [][]byte{sig, sig}
+
+	allOK, perSig := VerifyBatchMulti(pks, msgs, sigs)
+	if allOK {
+		t.Error("VerifyBatchMulti: allOK = true, want false")
+	}
+	if !perSig[0] {
+		t.Error("triple 0: expected pkA's own signature to verify")
+	}
+	if perSig[1] {
+		t.Error("triple 1: expected sig under pkA to fail against pkB")
+	}
+}
+
+// TestBatchVerifyMixedKeys is TestVerifyBatchMultiMixedKeys against the
+// VerifyItem-based BatchVerify API instead of VerifyBatchMulti's parallel
+// slices.
+func TestBatchVerifyMixedKeys(t *testing.T) {
+	pkA, skA := Gen(make([]byte, 32))
+	pkB, skB := Gen(bytes.Repeat([]byte{1}, 32))
+
+	msgsA := [][]byte{[]byte("a message one"), []byte("a message two")}
+	msgsB := [][]byte{[]byte("b message one"), []byte("b message two"), []byte("b message three")}
+	sigsA := SignBatch(skA, msgsA)
+	sigsB := SignBatch(skB, msgsB)
+
+	var items []VerifyItem
+	for i := range msgsA {
+		items = append(items, VerifyItem{PK: pkA, Msg: msgsA[i], Sig: sigsA[i]})
+	}
+	for i := range msgsB {
+		items = append(items, VerifyItem{PK: pkB, Msg: msgsB[i], Sig: sigsB[i]})
+	}
+
+	oks := BatchVerify(items)
+	if len(oks) != len(items) {
+		t.Fatalf("BatchVerify returned %d results, want %d", len(oks), len(items))
+	}
+	for i, ok := range oks {
+		if !ok {
+			t.Errorf("item %d: BatchVerify rejected a valid signature", i)
+		}
+	}
+}
+
+// TestBatchVerifyCatchesWrongKey is TestVerifyBatchMultiCatchesWrongKey
+// against BatchVerify.
+func TestBatchVerifyCatchesWrongKey(t *testing.T) {
+	pkA, skA := Gen(make([]byte, 32))
+	pkB, _ := Gen(bytes.Repeat([]byte{1}, 32))
+
+	msg := []byte("shared message")
+	sig := Sign(skA, msg)
+
+	items := []VerifyItem{
+		{PK: pkA, Msg: msg, Sig: sig},
+		{PK: pkB, Msg: msg, Sig: sig},
+	}
+
+	oks := BatchVerify(items)
+	if !oks[0] {
+		t.Error("item 0: expected pkA's own signature to verify")
+	}
+	if oks[1] {
+		t.Error("item 1: expected sig under pkA to fail against pkB")
+	}
+}
+
+// TestBatchVerifyEmpty checks BatchVerify handles an empty batch.
+func TestBatchVerifyEmpty(t *testing.T) {
+	oks := BatchVerify(nil)
+	if len(oks) != 0 {
+		t.Errorf("BatchVerify(nil) returned %d results, want 0", len(oks))
+	}
+}
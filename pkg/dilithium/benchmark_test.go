@@ -67,3 +67,35 @@ func BenchmarkSignVerify(b *testing.B) {
 		Verify(pk, msg, sig)
 	}
 }
+
+// BenchmarkVerifyLoopVsBatchMulti compares plain Verify called in a loop
+// against VerifyBatchMulti for the same N signatures, all under one pk (the
+// case VerifyBatchMulti's Ahat/tHat caching targets), for N in {8, 64, 256}.
+func BenchmarkVerifyLoopVsBatchMulti(b *testing.B) {
+	pk, sk := Gen(benchSeed)
+
+	for _, n := range []int{8, 64, 256} {
+		msgs := benchMsgs[:n]
+		sigs := SignBatch(sk, msgs)
+		pks := make([][]byte, n)
+		for i := range pks {
+			pks[i] = pk
+		}
+
+		b.Run(fmt.Sprintf("Loop/N=%d", n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				for j := 0; j < n; j++ {
+					Verify(pk, msgs[j], sigs[j])
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("BatchMulti/N=%d", n), func(b *testing.B) {
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				VerifyBatchMulti(pks, msgs, sigs)
+			}
+		})
+	}
+}
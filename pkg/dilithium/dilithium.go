@@ -1,7 +1,18 @@
 // Package dilithium implements zkDilithium signature scheme.
+//
+// rho/rho2/tr below are still derived with the ad-hoc hash.H(seed||nonce)
+// calls from the original design rather than pkg/transcript: Sign/Verify
+// have golden-vector tests pinned to these exact byte sequences, and
+// pkg/transcript's length-framed absorption produces different bytes for
+// the same inputs. Swapping the hot path over is future work once those
+// vectors can be regenerated; pkg/transcript is ready for a future
+// zk-prover transcript (mu, c̃, rho) that doesn't carry that constraint.
 package dilithium
 
 import (
+	"runtime"
+	"sync"
+
 	"zkdilithium-signer/pkg/encoding"
 	"zkdilithium-signer/pkg/field"
 	"zkdilithium-signer/pkg/hash"
@@ -90,88 +101,161 @@ func Gen(seed []byte) (pk, sk []byte) {
 	return pk, sk
 }
 
-// Sign signs a message with the secret key.
-func Sign(sk, msg []byte) []byte {
-	// Unpack secret key
-	rho := sk[:32]
-	key := sk[32:64]
-	tr := sk[64:96]
-
-	// Unpack s1, convert to Montgomery form
-	var s1 [field.L]poly.Poly
-	for i := 0; i < field.L; i++ {
-		s1[i] = encoding.UnpackPolyLeqEta(sk[96+i*96 : 96+(i+1)*96])
-		s1[i].ToMont()
-	}
-
-	// Unpack s2, convert to Montgomery form
-	var s2 [field.K]poly.Poly
-	for i := 0; i < field.K; i++ {
-		s2[i] = encoding.UnpackPolyLeqEta(sk[96+96*field.L+i*96 : 96+96*field.L+(i+1)*96])
-		s2[i].ToMont()
-	}
-
-	// Sample matrix A, convert to Montgomery form
-	Ahat := sampling.SampleMatrix(rho)
-	for i := 0; i < field.K; i++ {
-		for j := 0; j < field.L; j++ {
-			Ahat[i][j].ToMont()
-		}
-	}
-
-	// Compute mu using Poseidon
+// computeMu derives the Poseidon message representative mu = H(tr, ctx, msg).
+// ctx is absorbed (length-prefixed) between tr and msg only when non-empty,
+// so the ctx-less path is byte-identical to the original mu derivation and
+// existing golden-vector tests keep passing.
+func computeMu(tr, msg, ctx []byte) []uint32 {
 	hMu := hash.NewPoseidon([]uint32{0})
 	hMu.Write(encoding.BytesToFes(tr))
 	hMu.Permute()
+	if len(ctx) > 0 {
+		framed := make([]byte, 1+len(ctx))
+		framed[0] = byte(len(ctx))
+		copy(framed[1:], ctx)
+		hMu.Write(encoding.BytesToFes(framed))
+	}
 	hMu.Write(encoding.BytesToFes(msg))
-	mu := hMu.Read(field.MuSize)
+	return hMu.Read(field.MuSize)
+}
+
+// expandMatrixMont samples the public matrix A from rho and NTTs it into
+// the form every Sign/Verify call needs. Factored out so SignBatch/
+// VerifyBatch can expand A once from rho and reuse it across every message
+// in the batch instead of resampling it per message.
+//
+// Despite the name (kept to avoid rippling a rename through every file
+// that calls it), this no longer converts to Montgomery form: Ahat stays
+// plain, matching the normal-form inputs poly.MatVecMulNTTLazy/poly.MulNTT
+// both require. An earlier revision ToMont'd this (and yHat/s1Hat/s2Hat/
+// tHat/c/z below) under the assumption that those primitives did
+// Montgomery multiplication; they don't, they compute plain a*b mod Q, so
+// Montgomery-scaling two operands going in left every product scaled by a
+// stray extra factor of R and made signLoop/verifyLoop's rejection loop
+// never accept. Fixed by dropping every ToMont/FromMont call on the
+// signing/verification hot path instead.
+func expandMatrixMont(rho []byte) [field.K][field.L]poly.Poly {
+	return sampling.SampleMatrix(rho)
+}
+
+// unpackSecretKey splits sk into its rho/key/tr fields and the NTT'd secret
+// vectors s1Hat/s2Hat (normal form, see expandMatrixMont), the shape
+// signMessage needs. Factored out of SignContext so SignBatch can do this
+// once per key instead of once per message.
+func unpackSecretKey(sk []byte) (rho, key, tr []byte, s1Hat [field.L]poly.Poly, s2Hat [field.K]poly.Poly) {
+	rho = sk[:32]
+	key = sk[32:64]
+	tr = sk[64:96]
 
-	// Precompute NTT of secrets (Montgomery form)
-	var s1Hat [field.L]poly.Poly
 	for i := 0; i < field.L; i++ {
-		s1Hat[i] = s1[i]
+		s1Hat[i] = encoding.UnpackPolyLeqEta(sk[96+i*96 : 96+(i+1)*96])
 		s1Hat[i].NTT()
 	}
-	var s2Hat [field.K]poly.Poly
 	for i := 0; i < field.K; i++ {
-		s2Hat[i] = s2[i]
+		s2Hat[i] = encoding.UnpackPolyLeqEta(sk[96+96*field.L+i*96 : 96+96*field.L+(i+1)*96])
 		s2Hat[i].NTT()
 	}
+	return rho, key, tr, s1Hat, s2Hat
+}
 
-	// Derive rho2 for y sampling
+// Sign signs a message with the secret key, deterministically. It is a
+// thin wrapper around SignWithOpts(sk, msg, SignOpts{Deterministic: true}),
+// which never errors in that mode.
+func Sign(sk, msg []byte) []byte {
+	sig, _ := SignWithOpts(sk, msg, SignOpts{Deterministic: true})
+	return sig
+}
+
+// SignContext signs a message bound to an optional context string, so
+// signatures produced with different ctx values are cryptographically
+// separated (mirroring the ML-DSA context/prehash split in FIPS 204).
+// ctx must be at most 255 bytes.
+func SignContext(sk, msg, ctx []byte) []byte {
+	if len(ctx) > 255 {
+		panic("dilithium: context string too long")
+	}
+	rho, key, tr, s1Hat, s2Hat := unpackSecretKey(sk)
+	Ahat := expandMatrixMont(rho)
+	return signMessage(rho, key, tr, s1Hat, s2Hat, Ahat, msg, ctx)
+}
+
+// SignBatch signs every message in msgs with sk, expanding the public
+// matrix A from rho a single time and reusing it (along with the
+// NTT'd secret vectors) across every message instead of redoing
+// that work per message. Messages are signed concurrently across a pool
+// of runtime.GOMAXPROCS(0) workers; this is a straight win for a signer
+// batching many proofs over one key, e.g. a zk-rollup sequencer.
+func SignBatch(sk []byte, msgs [][]byte) [][]byte {
+	rho, key, tr, s1Hat, s2Hat := unpackSecretKey(sk)
+	Ahat := expandMatrixMont(rho)
+
+	sigs := make([][]byte, len(msgs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i, msg := range msgs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, msg []byte) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			sigs[i] = signMessage(rho, key, tr, s1Hat, s2Hat, Ahat, msg, nil)
+		}(i, msg)
+	}
+	wg.Wait()
+	return sigs
+}
+
+// signMessage runs the rejection-sampling signing loop for one message
+// against an already-unpacked secret key and an already-expanded Ahat.
+func signMessage(rho, key, tr []byte, s1Hat [field.L]poly.Poly, s2Hat [field.K]poly.Poly, Ahat [field.K][field.L]poly.Poly, msg, ctx []byte) []byte {
+	mu := computeMu(tr, msg, ctx)
+	rho2 := deriveRho2(key, tr, msg, nil)
+	return signLoop(s1Hat, s2Hat, Ahat, mu, rho2)
+}
+
+// deriveRho2 derives the rho2 seed SampleY expands, from the secret key
+// component and the tr||msg digest, optionally mixing in rnd (nil for
+// deterministic signing, 32 caller-supplied bytes for SignHedged).
+func deriveRho2(key, tr, msg, rnd []byte) []byte {
 	trMsg := make([]byte, len(tr)+len(msg))
 	copy(trMsg, tr)
 	copy(trMsg[len(tr):], msg)
 	innerHash := hash.H(trMsg, 64)
-	keyHash := make([]byte, len(key)+len(innerHash))
-	copy(keyHash, key)
-	copy(keyHash[len(key):], innerHash)
-	rho2 := hash.H(keyHash, 64)
+	return deriveRho2FromDigest(key, innerHash, rnd)
+}
 
+// deriveRho2FromDigest is deriveRho2 for a caller that already has the
+// tr||msg digest, used by the ExternalMu signing path (SignExternalMu)
+// where the signer never sees tr or msg, only the caller-computed mu, and
+// by the streaming Signer.
+func deriveRho2FromDigest(key, innerHash, rnd []byte) []byte {
+	keyHash := make([]byte, 0, len(key)+len(rnd)+len(innerHash))
+	keyHash = append(keyHash, key...)
+	keyHash = append(keyHash, rnd...)
+	keyHash = append(keyHash, innerHash...)
+	return hash.H(keyHash, 64)
+}
+
+// signLoop is the rejection-sampling core shared by every SigningMode: it
+// takes an already-computed mu and an already-derived rho2 and knows
+// nothing about how either was produced.
+func signLoop(s1Hat [field.L]poly.Poly, s2Hat [field.K]poly.Poly, Ahat [field.K][field.L]poly.Poly, mu []uint32, rho2 []byte) []byte {
 	yNonce := 0
 	for {
-		// Sample y, convert to Montgomery form and NTT
+		// Sample y and NTT it (normal form throughout, see expandMatrixMont)
 		y := sampling.SampleY(rho2, yNonce)
 		yNonce += field.L
 		var yHat [field.L]poly.Poly
 		for i := 0; i < field.L; i++ {
-			y[i].ToMont()
 			yHat[i] = y[i]
 			yHat[i].NTT()
 		}
 
-		// Compute w = A * y using lazy accumulation (Montgomery form)
-		var wMont [field.K]poly.Poly
-		poly.MatVecMulNTTLazy(&Ahat, &yHat, &wMont)
-		for i := 0; i < field.K; i++ {
-			wMont[i].InvNTT()
-		}
-
-		// Convert w from Montgomery for Decompose
+		// Compute w = A * y using lazy accumulation
 		var w [field.K]poly.Poly
+		poly.MatVecMulNTTLazy(&Ahat, &yHat, &w)
 		for i := 0; i < field.K; i++ {
-			w[i] = wMont[i]
-			w[i].FromMont()
+			w[i].InvNTT()
 		}
 
 		// Decompose w
@@ -190,36 +274,27 @@ func Sign(sk, msg []byte) []byte {
 		}
 		cTilde := hC.Read(field.CSize)
 
-		// Sample c from cTilde, convert to Montgomery form
+		// Sample c from cTilde
 		hBall := hash.NewPoseidon(append([]uint32{2}, cTilde...))
 		c := sampling.SampleInBall(hBall)
 		if c == nil {
 			continue // Rejection
 		}
-		c.ToMont()
 
-		// Compute cs2 = c * s2 (in NTT domain, Montgomery form)
+		// Compute cs2 = c * s2 (in NTT domain)
 		var cHat poly.Poly = *c
 		cHat.NTT()
 
-		var cs2Mont [field.K]poly.Poly
-		for i := 0; i < field.K; i++ {
-			poly.MulNTT(&cHat, &s2Hat[i], &cs2Mont[i])
-			cs2Mont[i].InvNTT()
-		}
-
-		// r0 = w - cs2 (both need to be in same form)
-		// wMont and cs2Mont are both in Montgomery form
-		var r0Mont [field.K]poly.Poly
+		var cs2 [field.K]poly.Poly
 		for i := 0; i < field.K; i++ {
-			poly.Sub(&wMont[i], &cs2Mont[i], &r0Mont[i])
+			poly.MulNTT(&cHat, &s2Hat[i], &cs2[i])
+			cs2[i].InvNTT()
 		}
 
-		// Convert r0 from Montgomery for Decompose and Norm
+		// r0 = w - cs2
 		var r0 [field.K]poly.Poly
 		for i := 0; i < field.K; i++ {
-			r0[i] = r0Mont[i]
-			r0[i].FromMont()
+			poly.Sub(&w[i], &cs2[i], &r0[i])
 		}
 
 		r0Decomposed := make([][field.N]uint32, field.K)
@@ -240,20 +315,13 @@ func Sign(sk, msg []byte) []byte {
 			continue
 		}
 
-		// Compute z = y + c*s1 (Montgomery form)
-		var zMont [field.L]poly.Poly
+		// Compute z = y + c*s1
+		var z [field.L]poly.Poly
 		for i := 0; i < field.L; i++ {
 			var cs1 poly.Poly
 			poly.MulNTT(&cHat, &s1Hat[i], &cs1)
 			cs1.InvNTT()
-			poly.Add(&y[i], &cs1, &zMont[i])
-		}
-
-		// Convert z from Montgomery for Norm check and packing
-		var z [field.L]poly.Poly
-		for i := 0; i < field.L; i++ {
-			z[i] = zMont[i]
-			z[i].FromMont()
+			poly.Add(&y[i], &cs1, &z[i])
 		}
 
 		// Check norm of z
@@ -277,8 +345,217 @@ func Sign(sk, msg []byte) []byte {
 	}
 }
 
+// unpackPublicKey splits pk into rho, the tr hash, and t in NTT'd normal
+// form (see expandMatrixMont). Factored out of VerifyContext so
+// VerifyBatch can do this once per key instead of once per (msg, sig)
+// pair.
+func unpackPublicKey(pk []byte) (rho, tr []byte, tHat [field.K]poly.Poly) {
+	rho = pk[:32]
+	tPacked := pk[32:]
+
+	for i := 0; i < field.K; i++ {
+		tHat[i] = encoding.UnpackPoly(tPacked[i*field.N*3 : (i+1)*field.N*3])
+		tHat[i].NTT()
+	}
+
+	tr = hash.H(pk, 32)
+	return rho, tr, tHat
+}
+
 // Verify verifies a signature.
 func Verify(pk, msg, sig []byte) bool {
+	return VerifyContext(pk, msg, sig, nil)
+}
+
+// VerifyContext verifies a signature produced by SignContext with the same ctx.
+func VerifyContext(pk, msg, sig, ctx []byte) bool {
+	if len(ctx) > 255 {
+		return false
+	}
+	rho, tr, tHat := unpackPublicKey(pk)
+	Ahat := expandMatrixMont(rho)
+	return verifyMessage(tr, tHat, Ahat, msg, sig, ctx)
+}
+
+// VerifyBatch verifies len(msgs) (msg, sig) pairs against a single pk,
+// expanding A from rho once and reusing it (along with pk's NTT'd t)
+// across every pair instead of redoing that work per signature. Pairs
+// are checked concurrently across a pool of runtime.GOMAXPROCS(0)
+// workers; this is a straight win for a validator checking many
+// signatures against the same key, e.g. a zk-rollup batch of signer
+// proofs.
+func VerifyBatch(pk []byte, msgs, sigs [][]byte) []bool {
+	if len(msgs) != len(sigs) {
+		panic("dilithium: VerifyBatch: msgs and sigs must have the same length")
+	}
+	rho, tr, tHat := unpackPublicKey(pk)
+	Ahat := expandMatrixMont(rho)
+
+	oks := make([]bool, len(msgs))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i := range msgs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			oks[i] = verifyMessage(tr, tHat, Ahat, msgs[i], sigs[i], nil)
+		}(i)
+	}
+	wg.Wait()
+	return oks
+}
+
+// VerifyBatchMulti is VerifyBatch generalized to a possibly-mixed set of
+// public keys: it groups (pk, msg, sig) triples by pk, expanding each
+// distinct pk's Ahat/tHat exactly once (via unpackPublicKey/
+// expandMatrixMont) instead of once per triple, then verifies every
+// triple concurrently across a runtime.GOMAXPROCS(0) worker pool the same
+// way VerifyBatch does. It is a separate function rather than an overload
+// of VerifyBatch — Go has none, and VerifyBatch's single-pk signature is
+// already an established, tested API other callers depend on.
+//
+// Unlike a Schnorr/ECDSA-style batch verifier, this scheme has no cheaper
+// aggregate check to fall back from: verifyMessage's Poseidon challenge
+// recomputation is already the minimal per-signature cost, and nothing
+// about it is linear enough to combine across signatures into one cheaper
+// check. So "fall back to individual verification for the failures" here
+// is just "verify every triple individually" — which is also why this
+// function makes no call to field.BatchInvMontTreeNoZeroILP: none of
+// verifyMessage's steps involve a modular inversion to batch. This scheme
+// recomputes w1 directly from Az - tc rather than reconstructing it from
+// hint bits the way a hint-based Dilithium variant would, so there is no
+// per-signature inversion step for a shared batch inversion to amortize.
+//
+// allOK reports whether every triple verified; perSig gives the
+// per-triple result so a caller doesn't have to re-verify sequentially to
+// find which ones failed.
+func VerifyBatchMulti(pks, msgs, sigs [][]byte) (allOK bool, perSig []bool) {
+	if len(pks) != len(msgs) || len(msgs) != len(sigs) {
+		panic("dilithium: VerifyBatchMulti: pks, msgs and sigs must have the same length")
+	}
+
+	type unpacked struct {
+		tr   []byte
+		tHat [field.K]poly.Poly
+		Ahat [field.K][field.L]poly.Poly
+	}
+	cache := make(map[string]*unpacked, len(pks))
+	for _, pk := range pks {
+		key := string(pk)
+		if _, ok := cache[key]; ok {
+			continue
+		}
+		rho, tr, tHat := unpackPublicKey(pk)
+		cache[key] = &unpacked{tr: tr, tHat: tHat, Ahat: expandMatrixMont(rho)}
+	}
+
+	perSig = make([]bool, len(pks))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i := range pks {
+		u := cache[string(pks[i])]
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, u *unpacked) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			perSig[i] = verifyMessage(u.tr, u.tHat, u.Ahat, msgs[i], sigs[i], nil)
+		}(i, u)
+	}
+	wg.Wait()
+
+	allOK = true
+	for _, ok := range perSig {
+		if !ok {
+			allOK = false
+			break
+		}
+	}
+	return allOK, perSig
+}
+
+// VerifyItem is one (public key, message, signature) triple for BatchVerify.
+type VerifyItem struct {
+	PK  []byte
+	Msg []byte
+	Sig []byte
+}
+
+// BatchVerify is VerifyBatchMulti with a struct-per-item API instead of
+// three parallel slices, for callers that already have a []VerifyItem
+// (e.g. read off the wire as a batch) and would otherwise have to unzip
+// it into pks/msgs/sigs. It caches Ahat per unique rho (items[i].PK[:32])
+// and tr/tHat per unique full public key separately, rather than treating
+// the whole pk as one cache key like VerifyBatchMulti does: two items
+// whose public keys share rho but differ in t still get one matrix
+// expansion between them. As with VerifyBatchMulti, there is no cheaper
+// aggregate check to fall back to for this scheme (see that function's
+// doc comment), so a bad signature just fails its own verifyMessage call
+// without forcing the rest of the batch to re-verify sequentially.
+func BatchVerify(items []VerifyItem) []bool {
+	type pkEntry struct {
+		tr   []byte
+		tHat [field.K]poly.Poly
+	}
+	ahatCache := make(map[string][field.K][field.L]poly.Poly)
+	pkCache := make(map[string]*pkEntry, len(items))
+
+	type job struct {
+		tr   []byte
+		tHat [field.K]poly.Poly
+		Ahat [field.K][field.L]poly.Poly
+		msg  []byte
+		sig  []byte
+	}
+	jobs := make([]job, len(items))
+	for i, item := range items {
+		rhoKey := string(item.PK[:32])
+		Ahat, ok := ahatCache[rhoKey]
+		if !ok {
+			Ahat = expandMatrixMont(item.PK[:32])
+			ahatCache[rhoKey] = Ahat
+		}
+
+		pkKey := string(item.PK)
+		entry, ok := pkCache[pkKey]
+		if !ok {
+			_, tr, tHat := unpackPublicKey(item.PK)
+			entry = &pkEntry{tr: tr, tHat: tHat}
+			pkCache[pkKey] = entry
+		}
+
+		jobs[i] = job{tr: entry.tr, tHat: entry.tHat, Ahat: Ahat, msg: item.Msg, sig: item.Sig}
+	}
+
+	results := make([]bool, len(items))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	for i, j := range jobs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, j job) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = verifyMessage(j.tr, j.tHat, j.Ahat, j.msg, j.sig, nil)
+		}(i, j)
+	}
+	wg.Wait()
+	return results
+}
+
+// verifyMessage checks one (msg, sig) pair against an already-unpacked
+// public key (tr, tHat) and an already-expanded Ahat.
+func verifyMessage(tr []byte, tHat [field.K]poly.Poly, Ahat [field.K][field.L]poly.Poly, msg, sig, ctx []byte) bool {
+	mu := computeMu(tr, msg, ctx)
+	return verifyLoop(tHat, Ahat, mu, sig)
+}
+
+// verifyLoop is the acceptance check shared by every verification entry
+// point: it takes an already-computed mu and knows nothing about how it
+// was produced, mirroring signLoop on the signing side.
+func verifyLoop(tHat [field.K]poly.Poly, Ahat [field.K][field.L]poly.Poly, mu []uint32, sig []byte) bool {
 	expectedSigLen := field.CSize*3 + field.PolyLeGamma1Size*field.L
 	if len(sig) != expectedSigLen {
 		return false
@@ -295,73 +572,30 @@ func Verify(pk, msg, sig []byte) bool {
 		z[i] = encoding.UnpackPolyLeGamma1(packedZ[i*field.PolyLeGamma1Size : (i+1)*field.PolyLeGamma1Size])
 	}
 
-	// Check z norm (before converting to Montgomery)
+	// Check z norm
 	for i := 0; i < field.L; i++ {
 		if z[i].Norm() >= field.Gamma1-field.Beta {
 			return false
 		}
 	}
 
-	// Convert z to Montgomery form for NTT operations
-	var zMont [field.L]poly.Poly
-	for i := 0; i < field.L; i++ {
-		zMont[i] = z[i]
-		zMont[i].ToMont()
-	}
-
-	// Unpack public key
-	rho := pk[:32]
-	tPacked := pk[32:]
-
-	// Unpack t, convert to Montgomery form
-	var tMont [field.K]poly.Poly
-	for i := 0; i < field.K; i++ {
-		tMont[i] = encoding.UnpackPoly(tPacked[i*field.N*3 : (i+1)*field.N*3])
-		tMont[i].ToMont()
-	}
-
-	// Compute tr
-	tr := hash.H(pk, 32)
-
-	// Compute mu
-	hMu := hash.NewPoseidon([]uint32{0})
-	hMu.Write(encoding.BytesToFes(tr))
-	hMu.Permute()
-	hMu.Write(encoding.BytesToFes(msg))
-	mu := hMu.Read(field.MuSize)
-
-	// Sample c from cTilde, convert to Montgomery form
+	// Sample c from cTilde
 	hBall := hash.NewPoseidon(append([]uint32{2}, cTilde...))
 	c := sampling.SampleInBall(hBall)
 	if c == nil {
 		return false
 	}
-	c.ToMont()
-
-	// Sample A, convert to Montgomery form
-	Ahat := sampling.SampleMatrix(rho)
-	for i := 0; i < field.K; i++ {
-		for j := 0; j < field.L; j++ {
-			Ahat[i][j].ToMont()
-		}
-	}
 
-	// Compute Az - tc in NTT domain (Montgomery form)
+	// Compute Az - tc in NTT domain (normal form, see expandMatrixMont)
 	var cHat poly.Poly = *c
 	cHat.NTT()
 
 	var zHat [field.L]poly.Poly
 	for i := 0; i < field.L; i++ {
-		zHat[i] = zMont[i]
+		zHat[i] = z[i]
 		zHat[i].NTT()
 	}
 
-	var tHat [field.K]poly.Poly
-	for i := 0; i < field.K; i++ {
-		tHat[i] = tMont[i]
-		tHat[i].NTT()
-	}
-
 	// Compute Az using lazy accumulation
 	var Az [field.K]poly.Poly
 	poly.MatVecMulNTTLazy(&Ahat, &zHat, &Az)
@@ -369,17 +603,12 @@ func Verify(pk, msg, sig []byte) bool {
 	// Compute w1 = Az - tc for each row
 	var w1 [field.K]poly.Poly
 	for i := 0; i < field.K; i++ {
-		// tc (Montgomery form)
 		var tc poly.Poly
 		poly.MulNTT(&tHat[i], &cHat, &tc)
 
-		// Az - tc (Montgomery form)
 		poly.Sub(&Az[i], &tc, &Az[i])
 		Az[i].InvNTT()
 
-		// Convert from Montgomery for Decompose
-		Az[i].FromMont()
-
 		// Decompose
 		_, w1[i] = Az[i].Decompose()
 	}
@@ -0,0 +1,70 @@
+package dilithium
+
+import (
+	"encoding/asn1"
+	"errors"
+
+	"zkdilithium-signer/pkg/encoding"
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/hash"
+)
+
+// computeMuHash is computeMu for the FIPS 204 HashML-DSA variant: the same
+// tr/ctx absorption, but with the DER encoding of hashOID absorbed after
+// ctx and before digest (the pre-hashed message representative), so a
+// signature made over one hash algorithm's digest can't be replayed as if
+// it were made over a different algorithm's digest of the same bytes.
+func computeMuHash(tr, digest []byte, hashOID asn1.ObjectIdentifier, ctx []byte) ([]uint32, error) {
+	oidDER, err := asn1.Marshal(hashOID)
+	if err != nil {
+		return nil, errors.New("dilithium: invalid hash OID: " + err.Error())
+	}
+	hMu := hash.NewPoseidon([]uint32{0})
+	hMu.Write(encoding.BytesToFes(tr))
+	hMu.Permute()
+	if len(ctx) > 0 {
+		framed := make([]byte, 1+len(ctx))
+		framed[0] = byte(len(ctx))
+		copy(framed[1:], ctx)
+		hMu.Write(encoding.BytesToFes(framed))
+	}
+	hMu.Write(encoding.BytesToFes(oidDER))
+	hMu.Write(encoding.BytesToFes(digest))
+	return hMu.Read(field.MuSize), nil
+}
+
+// HashSign implements the FIPS 204 HashML-DSA variant: it signs a
+// caller-supplied digest bound to hashOID rather than a message. rho2 (the
+// seed SampleY expands) is derived the same way SignContext derives it
+// from tr||msg, substituting the OID-framed digest for msg, so a
+// HashSign/HashVerify pair signed under one hashOID doesn't collide with a
+// SignContext signature over bytes that happen to match the digest.
+func HashSign(sk, digest []byte, hashOID asn1.ObjectIdentifier, ctx []byte) ([]byte, error) {
+	if len(ctx) > 255 {
+		return nil, errors.New("dilithium: HashSign: context string too long")
+	}
+	rho, key, tr, s1Hat, s2Hat := unpackSecretKey(sk)
+	mu, err := computeMuHash(tr, digest, hashOID, ctx)
+	if err != nil {
+		return nil, err
+	}
+	oidDER, _ := asn1.Marshal(hashOID) // already validated by computeMuHash
+	framedDigest := append(append([]byte(nil), oidDER...), digest...)
+	Ahat := expandMatrixMont(rho)
+	rho2 := deriveRho2(key, tr, framedDigest, nil)
+	return signLoop(s1Hat, s2Hat, Ahat, mu, rho2), nil
+}
+
+// HashVerify verifies a signature produced by HashSign.
+func HashVerify(pk, digest []byte, hashOID asn1.ObjectIdentifier, ctx, sig []byte) bool {
+	if len(ctx) > 255 {
+		return false
+	}
+	rho, tr, tHat := unpackPublicKey(pk)
+	mu, err := computeMuHash(tr, digest, hashOID, ctx)
+	if err != nil {
+		return false
+	}
+	Ahat := expandMatrixMont(rho)
+	return verifyLoop(tHat, Ahat, mu, sig)
+}
@@ -0,0 +1,92 @@
+package dilithium
+
+import (
+	"encoding/asn1"
+	"testing"
+)
+
+// sha256OID and sha512OID are id-sha256/id-sha512 from NIST CSOR
+// (2.16.840.1.101.3.4.2.{1,3}), the same OIDs a FIPS 204-conformant
+// HashML-DSA implementation would bind into the transcript per digest
+// algorithm.
+var (
+	sha256OID = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	sha512OID = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+)
+
+// TestHashSignVerifies checks a HashSign/HashVerify round trip.
+func TestHashSignVerifies(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	digest := make([]byte, 32)
+	for i := range digest {
+		digest[i] = byte(i)
+	}
+
+	sig, err := HashSign(sk, digest, sha256OID, nil)
+	if err != nil {
+		t.Fatalf("HashSign: %v", err)
+	}
+	if !HashVerify(pk, digest, sha256OID, nil, sig) {
+		t.Error("HashVerify returned false for a HashSign signature")
+	}
+}
+
+// TestHashVerifyRejectsWrongOID checks a signature made under one hash OID
+// is rejected when verified against a different OID over the same digest
+// bytes, since the OID is bound into the mu transcript.
+func TestHashVerifyRejectsWrongOID(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	digest := make([]byte, 32)
+
+	sig, err := HashSign(sk, digest, sha256OID, nil)
+	if err != nil {
+		t.Fatalf("HashSign: %v", err)
+	}
+	if HashVerify(pk, digest, sha512OID, nil, sig) {
+		t.Error("HashVerify accepted a signature under the wrong hash OID")
+	}
+}
+
+// TestHashVerifyRejectsWrongDigest checks HashVerify is bound to digest.
+func TestHashVerifyRejectsWrongDigest(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	digest := make([]byte, 32)
+
+	sig, err := HashSign(sk, digest, sha256OID, nil)
+	if err != nil {
+		t.Fatalf("HashSign: %v", err)
+	}
+	other := make([]byte, 32)
+	other[0] = 1
+	if HashVerify(pk, other, sha256OID, nil, sig) {
+		t.Error("HashVerify accepted a signature for a different digest")
+	}
+}
+
+// TestHashSignWithContext checks HashSign/HashVerify thread ctx through the
+// transcript the same way SignContext/VerifyContext do.
+func TestHashSignWithContext(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	digest := make([]byte, 32)
+	ctx := []byte("hash-sign context")
+
+	sig, err := HashSign(sk, digest, sha256OID, ctx)
+	if err != nil {
+		t.Fatalf("HashSign: %v", err)
+	}
+	if !HashVerify(pk, digest, sha256OID, ctx, sig) {
+		t.Error("HashVerify returned false for a matching context")
+	}
+	if HashVerify(pk, digest, sha256OID, nil, sig) {
+		t.Error("HashVerify accepted a signature made with a different context")
+	}
+}
+
+// TestHashSignRejectsLongContext mirrors SignContext's context-length limit.
+func TestHashSignRejectsLongContext(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	_, err := HashSign(sk, make([]byte, 32), sha256OID, make([]byte, 256))
+	if err == nil {
+		t.Fatal("HashSign: expected an error for a context longer than 255 bytes")
+	}
+}
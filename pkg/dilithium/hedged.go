@@ -0,0 +1,110 @@
+package dilithium
+
+import (
+	"errors"
+	"io"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+// SigningMode selects how a signature's rho2 seed (and hence its y
+// samples) is derived, mirroring the three ML-DSA (FIPS 204) signing
+// variants:
+//
+//   - Deterministic reproduces Sign/SignContext's existing behavior: rho2
+//     is derived only from the secret key and the message, so signing the
+//     same message twice yields byte-identical signatures.
+//   - Hedged mixes 32 bytes of caller-supplied randomness into rho2, so a
+//     faulty RNG can weaken but not break signing (unlike ECDSA/Schnorr,
+//     a repeated y here does not leak the secret key, but hedging is
+//     still cheap insurance against adversarial input crafted to bias
+//     rejection sampling).
+//   - ExternalMu accepts a caller-precomputed mu (e.g. from a hardware
+//     root of trust that hashed the message itself) instead of hashing
+//     tr||msg in-process.
+//
+// The three paths are Sign/SignContext/SignWithOpts (Deterministic),
+// SignHedged/SignWithOpts (Hedged) and SignExternalMu (ExternalMu); no
+// function in this package takes a SigningMode value.
+type SigningMode int
+
+const (
+	// Deterministic is today's Sign/SignContext behavior.
+	Deterministic SigningMode = iota
+	// Hedged mixes caller randomness into rho2. See SignHedged.
+	Hedged
+	// ExternalMu signs a caller-computed mu instead of a message. See
+	// SignExternalMu.
+	ExternalMu
+)
+
+// SignOpts configures SignWithOpts: whether to derive rho2 purely from the
+// secret key and message (Deterministic) or mix in caller randomness read
+// from Rand, mirroring the ML-DSA (FIPS 204) `PrivateKey.Sign(rand, msg,
+// opts)` deterministic/hedged switch. Named SignOpts rather than
+// SignerOpts to avoid colliding with signer.go's SignerOptions, which
+// configures the unrelated crypto.SignerOpts prehash/context switch.
+type SignOpts struct {
+	// Rand supplies the 32 bytes of randomness SignWithOpts mixes into
+	// rho2 when Deterministic is false. Required in that case.
+	Rand io.Reader
+	// Deterministic selects Sign/SignContext's existing behavior,
+	// ignoring Rand.
+	Deterministic bool
+}
+
+// SignWithOpts signs msg according to opts, dispatching to the
+// deterministic or hedged (SignHedged) derivation of rho2. Deterministic
+// mode never errors; hedged mode returns any error opts.Rand produces, or
+// an error if opts.Rand is nil.
+func SignWithOpts(sk, msg []byte, opts SignOpts) ([]byte, error) {
+	if opts.Deterministic {
+		return SignContext(sk, msg, nil), nil
+	}
+	if opts.Rand == nil {
+		return nil, errors.New("dilithium: SignWithOpts: Rand is required unless Deterministic is set")
+	}
+	return SignHedged(sk, msg, opts.Rand)
+}
+
+// SignHedged is Sign with 32 bytes read from rand mixed into the rho2
+// derivation (SigningMode Hedged), so a caller who doesn't trust
+// determinism alone (e.g. defense-in-depth against a fault-injection
+// attack that biases rejection sampling) can add fresh entropy per
+// signature without losing the fallback safety of the deterministic
+// derivation if rand turns out to be low-quality.
+func SignHedged(sk, msg []byte, rand io.Reader) ([]byte, error) {
+	rnd := make([]byte, 32)
+	if _, err := io.ReadFull(rand, rnd); err != nil {
+		return nil, errors.New("dilithium: SignHedged: " + err.Error())
+	}
+	rho, key, tr, s1Hat, s2Hat := unpackSecretKey(sk)
+	Ahat := expandMatrixMont(rho)
+	mu := computeMu(tr, msg, nil)
+	rho2 := deriveRho2(key, tr, msg, rnd)
+	return signLoop(s1Hat, s2Hat, Ahat, mu, rho2), nil
+}
+
+// SignExternalMu signs a caller-precomputed mu directly (SigningMode
+// ExternalMu), for callers that already have mu from a coprocessor or
+// hardware root of trust and never hold tr or msg in this process. muDigest
+// is the tr||msg digest that ordinarily feeds rho2 (see deriveRho2); a
+// caller in this position is expected to have derived it the same way the
+// hardware derived mu, or to pass mu's own bytes packed via
+// encoding.PackFes if it has nothing else to bind rho2 to.
+func SignExternalMu(sk []byte, mu []uint32, muDigest []byte, rand io.Reader) ([]byte, error) {
+	if len(mu) != field.MuSize {
+		return nil, errors.New("dilithium: SignExternalMu: mu must have field.MuSize elements")
+	}
+	var rnd []byte
+	if rand != nil {
+		rnd = make([]byte, 32)
+		if _, err := io.ReadFull(rand, rnd); err != nil {
+			return nil, errors.New("dilithium: SignExternalMu: " + err.Error())
+		}
+	}
+	rho, key, _, s1Hat, s2Hat := unpackSecretKey(sk)
+	Ahat := expandMatrixMont(rho)
+	rho2 := deriveRho2FromDigest(key, muDigest, rnd)
+	return signLoop(s1Hat, s2Hat, Ahat, mu, rho2), nil
+}
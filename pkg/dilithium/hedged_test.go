@@ -0,0 +1,115 @@
+package dilithium
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"zkdilithium-signer/pkg/hash"
+)
+
+func TestSignHedgedVerifies(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	sig, err := SignHedged(sk, []byte("test"), rand.Reader)
+	if err != nil {
+		t.Fatalf("SignHedged: %v", err)
+	}
+	if !Verify(pk, []byte("test"), sig) {
+		t.Error("Verify returned false for a SignHedged signature")
+	}
+}
+
+func TestSignHedgedVariesByRandomness(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	msg := []byte("test")
+
+	sig1, err := SignHedged(sk, msg, bytes.NewReader(bytes.Repeat([]byte{0}, 32)))
+	if err != nil {
+		t.Fatalf("SignHedged: %v", err)
+	}
+	sig2, err := SignHedged(sk, msg, bytes.NewReader(bytes.Repeat([]byte{1}, 32)))
+	if err != nil {
+		t.Fatalf("SignHedged: %v", err)
+	}
+	if bytes.Equal(sig1, sig2) {
+		t.Error("SignHedged with different randomness produced identical signatures")
+	}
+}
+
+func TestSignHedgedPropagatesReadError(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	wantErr := errors.New("boom")
+	_, err := SignHedged(sk, []byte("test"), errReader{wantErr})
+	if err == nil {
+		t.Fatal("SignHedged: expected an error from a failing rand.Reader")
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// TestSignExternalMuVerifies checks that a mu computed independently of
+// SignExternalMu (exactly as Sign/Verify would compute it) produces a
+// signature Verify accepts, i.e. SignExternalMu's rho2 derivation is
+// consistent with an mu computed off-process the same way tr||msg would be.
+func TestSignExternalMuVerifies(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	msg := []byte("test")
+
+	_, tr, _ := unpackPublicKey(pk)
+	mu := computeMu(tr, msg, nil)
+	muDigest := hash.H(append(append([]byte(nil), tr...), msg...), 64)
+
+	sig, err := SignExternalMu(sk, mu, muDigest, nil)
+	if err != nil {
+		t.Fatalf("SignExternalMu: %v", err)
+	}
+	if !Verify(pk, msg, sig) {
+		t.Error("Verify returned false for a SignExternalMu signature")
+	}
+}
+
+func TestSignExternalMuRejectsWrongMuLength(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	_, err := SignExternalMu(sk, make([]uint32, 1), nil, nil)
+	if err == nil {
+		t.Fatal("SignExternalMu: expected an error for wrong-length mu")
+	}
+}
+
+func TestSignWithOptsDeterministicMatchesSign(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	msg := []byte("test")
+
+	want := Sign(sk, msg)
+	got, err := SignWithOpts(sk, msg, SignOpts{Deterministic: true})
+	if err != nil {
+		t.Fatalf("SignWithOpts: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("SignWithOpts(Deterministic: true) did not match Sign")
+	}
+}
+
+func TestSignWithOptsHedgedVerifies(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	msg := []byte("test")
+
+	sig, err := SignWithOpts(sk, msg, SignOpts{Rand: rand.Reader})
+	if err != nil {
+		t.Fatalf("SignWithOpts: %v", err)
+	}
+	if !Verify(pk, msg, sig) {
+		t.Error("Verify returned false for a SignWithOpts(hedged) signature")
+	}
+}
+
+func TestSignWithOptsRequiresRandWhenHedged(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	_, err := SignWithOpts(sk, []byte("test"), SignOpts{})
+	if err == nil {
+		t.Fatal("SignWithOpts: expected an error when Rand is nil and Deterministic is false")
+	}
+}
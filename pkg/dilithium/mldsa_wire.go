@@ -0,0 +1,87 @@
+package dilithium
+
+import "zkdilithium-signer/pkg/field"
+
+// This file adds a few isolated FIPS 204 wire-format pieces that don't
+// depend on pkg/field's modulus: nonce encodings for ExpandA/ExpandS
+// (Algorithms 32 and 33) and the signature concatenation from
+// sigEncode/sigDecode (Algorithms 26/27, §8.2). It is not an ML-DSA
+// implementation, or even most of one — there is no ExpandA/ExpandS/
+// ExpandMask sampling, no hint mechanism, and (see Mode.Params) no field
+// backend that could produce standards-conformant z/hint bytes for
+// SigEncode to frame in the first place. No ACVP ML-DSA-65 KAT is
+// included here either: this session also has no network access to
+// fetch NIST's official vectors, so nothing could be checked against
+// them even once the rest of the above existed.
+//
+// Status: this request's share of "Add FIPS 204 (ML-DSA) compatibility
+// modes" is rejected as originally scoped — see mode.go's Params doc
+// comment. These nonce/framing helpers are real but narrow; they do not
+// add FIPS 204 signing capability, and the missing pieces (ExpandA/
+// ExpandS/ExpandMask sampling, the hint mechanism, a conformant NTT, KAT
+// cross-checks) are each bigger than this request and don't fit a single
+// commit.
+
+// ExpandANonce returns the seed suffix FIPS 204's ExpandA (Algorithm 32)
+// uses to derive matrix entry A[i][j]: row index i in the high byte,
+// column index j in the low byte. This already matches the nonce
+// zkDilithium's sampling.SampleMatrix passes to hash.XOF128
+// (uint16(256*i+j)), so ModeZKDilithium and ModeMLDSA* share the same
+// ExpandA call shape even though their seeds/output framing differ.
+func (m Mode) ExpandANonce(i, j int) uint16 {
+	return uint16(i)*256 + uint16(j)
+}
+
+// ExpandS1Nonce returns the nonce FIPS 204's ExpandS (Algorithm 33) uses
+// for s1 row i: just i. Matches sampling.SampleSecret's s1 loop.
+func (m Mode) ExpandS1Nonce(i int) uint16 {
+	return uint16(i)
+}
+
+// ExpandS2Nonce returns the nonce ExpandS uses for s2 row i: L+i, so s1
+// and s2 draw from disjoint nonce ranges out of the same XOF. Matches
+// sampling.SampleSecret's s2 loop.
+func (m Mode) ExpandS2Nonce(i int) uint16 {
+	return uint16(m.Params().L) + uint16(i)
+}
+
+// CTildeSize returns the byte length of the challenge hash c̃ for m.
+// FIPS 204 §5.3 sets it to lambda/4 (32/48/64 bytes for ML-DSA-44/65/87);
+// ModeZKDilithium keeps its existing Poseidon-domain c̃ length instead,
+// the field.CSize field elements encoding.PackFes packs it to.
+func (m Mode) CTildeSize() int {
+	switch m {
+	case ModeMLDSA44:
+		return 32
+	case ModeMLDSA65:
+		return 48
+	case ModeMLDSA87:
+		return 64
+	default:
+		return field.CSize * 3
+	}
+}
+
+// SigEncode concatenates a signature's three components exactly as FIPS
+// 204 Algorithm 26 does: cTilde || zPacked || hint, with no extra length
+// framing, since every component's length is fixed by the parameter set.
+func SigEncode(cTilde, zPacked, hint []byte) []byte {
+	sig := make([]byte, 0, len(cTilde)+len(zPacked)+len(hint))
+	sig = append(sig, cTilde...)
+	sig = append(sig, zPacked...)
+	sig = append(sig, hint...)
+	return sig
+}
+
+// SigDecode splits sig back into cTilde/zPacked/hint given their expected
+// sizes (Algorithm 27), the inverse of SigEncode. ok is false if sig's
+// length doesn't match the sum of the expected component sizes.
+func SigDecode(sig []byte, cTildeSize, zPackedSize, hintSize int) (cTilde, zPacked, hint []byte, ok bool) {
+	if len(sig) != cTildeSize+zPackedSize+hintSize {
+		return nil, nil, nil, false
+	}
+	cTilde = sig[:cTildeSize]
+	zPacked = sig[cTildeSize : cTildeSize+zPackedSize]
+	hint = sig[cTildeSize+zPackedSize:]
+	return cTilde, zPacked, hint, true
+}
@@ -0,0 +1,76 @@
+package dilithium
+
+import "testing"
+
+func TestExpandANonceMatchesSampleMatrixConvention(t *testing.T) {
+	var m Mode
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			got := m.ExpandANonce(i, j)
+			want := uint16(256*i + j)
+			if got != want {
+				t.Errorf("ExpandANonce(%d,%d) = %d, want %d", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestExpandSNoncesAreDisjoint(t *testing.T) {
+	m := ModeZKDilithium
+	l := m.Params().L
+
+	seen := make(map[uint16]bool)
+	for i := 0; i < l; i++ {
+		n := m.ExpandS1Nonce(i)
+		if seen[n] {
+			t.Fatalf("duplicate s1 nonce %d", n)
+		}
+		seen[n] = true
+	}
+	for i := 0; i < m.Params().K; i++ {
+		n := m.ExpandS2Nonce(i)
+		if seen[n] {
+			t.Fatalf("s2 nonce %d collides with an s1 nonce", n)
+		}
+		seen[n] = true
+		if n != uint16(l+i) {
+			t.Errorf("ExpandS2Nonce(%d) = %d, want %d", i, n, l+i)
+		}
+	}
+}
+
+func TestCTildeSizePerMode(t *testing.T) {
+	cases := map[Mode]int{
+		ModeMLDSA44: 32,
+		ModeMLDSA65: 48,
+		ModeMLDSA87: 64,
+	}
+	for mode, want := range cases {
+		if got := mode.CTildeSize(); got != want {
+			t.Errorf("%s.CTildeSize() = %d, want %d", mode, got, want)
+		}
+	}
+}
+
+func TestSigEncodeDecodeRoundTrip(t *testing.T) {
+	cTilde := []byte("c-tilde-bytes")
+	zPacked := []byte("z-packed-bytes-here")
+	hint := []byte("hint")
+
+	sig := SigEncode(cTilde, zPacked, hint)
+
+	gotC, gotZ, gotH, ok := SigDecode(sig, len(cTilde), len(zPacked), len(hint))
+	if !ok {
+		t.Fatal("SigDecode reported failure on a valid signature")
+	}
+	if string(gotC) != string(cTilde) || string(gotZ) != string(zPacked) || string(gotH) != string(hint) {
+		t.Fatalf("round trip mismatch: got (%q,%q,%q)", gotC, gotZ, gotH)
+	}
+}
+
+func TestSigDecodeRejectsWrongLength(t *testing.T) {
+	sig := SigEncode([]byte("a"), []byte("bb"), []byte("ccc"))
+	if _, _, _, ok := SigDecode(sig, 1, 2, 4); ok {
+		t.Fatal("expected SigDecode to reject a length mismatch")
+	}
+}
@@ -0,0 +1,115 @@
+package dilithium
+
+import "zkdilithium-signer/pkg/field"
+
+// ParameterSet captures the Dilithium-family tunables that currently live as
+// package-level constants in pkg/field (K, L, Eta, Gamma1, Gamma2, Tau, Beta).
+// It exists so a Mode can describe a parameter set without every caller
+// reaching into pkg/field directly.
+//
+// NOTE: Gen/Sign/Verify below are not yet parameterized over this struct —
+// they still use the pkg/field constants directly, which is exactly the
+// ParameterSet values of ModeZKDilithium. Threading ParameterSet through the
+// samplers, packers and top-level API (so ModeMLDSA* can produce FIPS 204
+// byte-for-byte compatible output) is tracked as follow-up work; see Mode
+// for the registry this will plug into.
+type ParameterSet struct {
+	K      int
+	L      int
+	Eta    uint32
+	Gamma1 uint32
+	Gamma2 uint32
+	Tau    int
+	Beta   uint32
+}
+
+// Mode identifies a signature variant: the zk-friendly Poseidon scheme this
+// repo implements and can actually sign/verify with today (ModeZKDilithium),
+// or one of the FIPS 204 ML-DSA parameter sets (ModeMLDSA*). The ML-DSA
+// modes are parameter-set and wire-framing metadata only — see Params'
+// doc comment below — there is no ML-DSA Gen/Sign/Verify in this package;
+// Mode has no constructor that wires ModeMLDSA* into one.
+type Mode int
+
+const (
+	// ModeZKDilithium is the Poseidon-based, zk-circuit-friendly variant
+	// implemented by Gen/Sign/Verify in this package.
+	ModeZKDilithium Mode = iota
+
+	// ModeMLDSA44 is the FIPS 204 ML-DSA-44 parameter set (metadata only;
+	// see the Mode and Params doc comments).
+	ModeMLDSA44
+
+	// ModeMLDSA65 is the FIPS 204 ML-DSA-65 parameter set (metadata only;
+	// see the Mode and Params doc comments).
+	ModeMLDSA65
+
+	// ModeMLDSA87 is the FIPS 204 ML-DSA-87 parameter set (metadata only;
+	// see the Mode and Params doc comments).
+	ModeMLDSA87
+)
+
+// String returns a human-readable mode name.
+func (m Mode) String() string {
+	switch m {
+	case ModeZKDilithium:
+		return "zkDilithium"
+	case ModeMLDSA44:
+		return "ML-DSA-44"
+	case ModeMLDSA65:
+		return "ML-DSA-65"
+	case ModeMLDSA87:
+		return "ML-DSA-87"
+	default:
+		return "unknown"
+	}
+}
+
+// Params returns the ParameterSet for a mode.
+//
+// UPDATED CAVEAT: FIPS 204 ML-DSA is specified over Z_8380417, while this
+// module's pkg/field is built around Q = 7340033, the Poseidon-friendly
+// prime the zk-circuit targets. pkg/field/dilithiumq now provides a
+// field.Prime backend over the real ML-DSA modulus, so — unlike when this
+// comment was first written — Gamma1/Gamma2 below are the real FIPS 204
+// values (defined in terms of Q = 8380417), not placeholders. That backend
+// only supplies the ring's arithmetic and a working (but not wire-format)
+// NTT, though: byte-for-byte ML-DSA conformance still needs the standard's
+// own bit-reversed NTT table, ExpandA/ExpandS/ExpandMask, and the
+// hint-based signing/verification mechanism, none of which exist yet — see
+// pkg/field/dilithiumq's package doc comment. So ModeMLDSA* parameter sets
+// are now numerically accurate, but Gen/Sign/Verify still only implement
+// ModeZKDilithium.
+//
+// Status: "Add FIPS 204 (ML-DSA) compatibility modes" is rejected as
+// originally scoped. What exists is parameter-set metadata plus isolated
+// wire-framing helpers (see mldsa_wire.go); producing byte-for-byte FIPS
+// 204 signatures needs the standard's own NTT table, ExpandA/ExpandS/
+// ExpandMask, and a hint mechanism, none of which are implemented, and
+// this backlog format (one request per commit, no access to NIST's ACVP
+// vectors to check conformance against) is the wrong shape to land that
+// as a drive-by addition. If standards interop is still wanted, it needs
+// its own scoped-down request — or several — not a claim riding on this
+// one's title.
+func (m Mode) Params() ParameterSet {
+	switch m {
+	case ModeZKDilithium:
+		return ParameterSet{
+			K:      field.K,
+			L:      field.L,
+			Eta:    field.Eta,
+			Gamma1: field.Gamma1,
+			Gamma2: field.Gamma2,
+			Tau:    field.Tau,
+			Beta:   field.Beta,
+		}
+	case ModeMLDSA44:
+		return ParameterSet{K: 4, L: 4, Eta: 2, Gamma1: 1 << 17, Gamma2: 95232, Tau: 39, Beta: 39 * 2}
+	case ModeMLDSA65:
+		return ParameterSet{K: 6, L: 5, Eta: 4, Gamma1: 1 << 19, Gamma2: 261888, Tau: 49, Beta: 49 * 4}
+	case ModeMLDSA87:
+		return ParameterSet{K: 8, L: 7, Eta: 2, Gamma1: 1 << 19, Gamma2: 261888, Tau: 60, Beta: 60 * 2}
+	default:
+		panic("dilithium: unknown mode")
+	}
+}
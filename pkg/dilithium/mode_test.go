@@ -0,0 +1,53 @@
+package dilithium
+
+import "testing"
+
+func TestModeParamsZKDilithium(t *testing.T) {
+	p := ModeZKDilithium.Params()
+	if p.K != 4 || p.L != 4 {
+		t.Errorf("ModeZKDilithium.Params() K=%d L=%d, want 4,4", p.K, p.L)
+	}
+}
+
+func TestModeParamsMLDSAShapes(t *testing.T) {
+	cases := []struct {
+		mode Mode
+		k, l int
+	}{
+		{ModeMLDSA44, 4, 4},
+		{ModeMLDSA65, 6, 5},
+		{ModeMLDSA87, 8, 7},
+	}
+	for _, c := range cases {
+		p := c.mode.Params()
+		if p.K != c.k || p.L != c.l {
+			t.Errorf("%s.Params() K=%d L=%d, want %d,%d", c.mode, p.K, p.L, c.k, c.l)
+		}
+	}
+}
+
+// TestModeParamsMLDSAGammas checks Gamma1/Gamma2 now hold the real FIPS
+// 204 values (see mode.go's updated caveat on Params) rather than the
+// zero-value placeholders from before pkg/field/dilithiumq existed.
+func TestModeParamsMLDSAGammas(t *testing.T) {
+	cases := []struct {
+		mode           Mode
+		gamma1, gamma2 uint32
+	}{
+		{ModeMLDSA44, 1 << 17, 95232},
+		{ModeMLDSA65, 1 << 19, 261888},
+		{ModeMLDSA87, 1 << 19, 261888},
+	}
+	for _, c := range cases {
+		p := c.mode.Params()
+		if p.Gamma1 != c.gamma1 || p.Gamma2 != c.gamma2 {
+			t.Errorf("%s.Params() Gamma1=%d Gamma2=%d, want %d,%d", c.mode, p.Gamma1, p.Gamma2, c.gamma1, c.gamma2)
+		}
+	}
+}
+
+func TestModeString(t *testing.T) {
+	if ModeMLDSA65.String() != "ML-DSA-65" {
+		t.Errorf("ModeMLDSA65.String() = %q, want ML-DSA-65", ModeMLDSA65.String())
+	}
+}
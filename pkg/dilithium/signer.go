@@ -0,0 +1,133 @@
+package dilithium
+
+import (
+	"crypto"
+	"crypto/sha512"
+	"errors"
+	"io"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+// PublicKey wraps a packed zkDilithium public key so it can be handed to
+// APIs that expect crypto.PublicKey (TLS, x509, JWT/COSE, ...).
+type PublicKey struct {
+	Bytes []byte
+}
+
+// Equal reports whether pub is the same public key as x, matching the
+// crypto.PublicKey convention used by ed25519.PublicKey/rsa.PublicKey.
+func (pub *PublicKey) Equal(x crypto.PublicKey) bool {
+	xx, ok := x.(*PublicKey)
+	if !ok {
+		return false
+	}
+	if len(pub.Bytes) != len(xx.Bytes) {
+		return false
+	}
+	for i := range pub.Bytes {
+		if pub.Bytes[i] != xx.Bytes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalBinary returns the packed public key bytes (rho || t), the same
+// encoding produced by Gen and consumed by Verify.
+func (pub *PublicKey) MarshalBinary() ([]byte, error) {
+	out := make([]byte, len(pub.Bytes))
+	copy(out, pub.Bytes)
+	return out, nil
+}
+
+// UnmarshalBinary loads a public key previously produced by MarshalBinary.
+func (pub *PublicKey) UnmarshalBinary(data []byte) error {
+	pub.Bytes = append([]byte(nil), data...)
+	return nil
+}
+
+// PrivateKey wraps a packed zkDilithium secret key and implements
+// crypto.Signer so it can be used as a drop-in signer with TLS/x509/JWT
+// stacks without callers reaching into the low-level poly/sampling API.
+type PrivateKey struct {
+	Bytes []byte
+	pub   PublicKey
+}
+
+// NewPrivateKey generates a keypair from seed and wraps it as a PrivateKey.
+func NewPrivateKey(seed []byte) *PrivateKey {
+	pk, sk := Gen(seed)
+	return &PrivateKey{Bytes: sk, pub: PublicKey{Bytes: pk}}
+}
+
+// Public returns the corresponding public key, satisfying crypto.Signer.
+func (priv *PrivateKey) Public() crypto.PublicKey {
+	return &priv.pub
+}
+
+// MarshalBinary returns the packed secret key bytes.
+func (priv *PrivateKey) MarshalBinary() ([]byte, error) {
+	out := make([]byte, len(priv.Bytes))
+	copy(out, priv.Bytes)
+	return out, nil
+}
+
+// UnmarshalBinary loads a secret key previously produced by MarshalBinary.
+// The corresponding PublicKey is recovered from the rho prefix and the
+// trailing tPacked field of the secret key blob (see Gen's sk layout), so
+// pub is available without a reseed.
+func (priv *PrivateKey) UnmarshalBinary(data []byte) error {
+	priv.Bytes = append([]byte(nil), data...)
+	rho := priv.Bytes[:32]
+	tPacked := priv.Bytes[len(priv.Bytes)-field.K*field.N*3:]
+	pk := make([]byte, 0, len(rho)+len(tPacked))
+	pk = append(pk, rho...)
+	pk = append(pk, tPacked...)
+	priv.pub = PublicKey{Bytes: pk}
+	return nil
+}
+
+// SignerOptions implements crypto.SignerOpts and selects between "pure"
+// signing (the message is absorbed directly) and "prehash-SHA512" signing
+// (the caller has already hashed the message with SHA-512), plus an
+// optional context string for domain separation.
+type SignerOptions struct {
+	Context []byte
+	Prehash bool
+}
+
+// HashFunc satisfies crypto.SignerOpts. It returns crypto.SHA512 for the
+// prehash mode and crypto.Hash(0) ("no hash", i.e. pure signing) otherwise.
+func (o *SignerOptions) HashFunc() crypto.Hash {
+	if o.Prehash {
+		return crypto.SHA512
+	}
+	return crypto.Hash(0)
+}
+
+// Sign implements crypto.Signer. When opts is a *SignerOptions with
+// Prehash set, digest must already be the SHA-512 digest of the message;
+// otherwise digest is treated as the message itself (the "pure" convention
+// used by ed25519.Sign). rand is accepted for interface compatibility but
+// is unused: signing is deterministic (see SignHedged for randomized
+// signing).
+//
+// NOTE: in prehash mode this only checks that digest is 64 bytes and then
+// signs it as a message via SignContext; no hash OID is bound into the
+// transcript. HashSign/HashVerify implement the HashML-DSA variant that
+// DER-encodes the hash OID into the transcript.
+func (priv *PrivateKey) Sign(rand io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	var ctx []byte
+	prehash := false
+	if so, ok := opts.(*SignerOptions); ok {
+		ctx = so.Context
+		prehash = so.Prehash
+	} else if opts != nil && opts.HashFunc() != crypto.Hash(0) {
+		return nil, errors.New("dilithium: only pure signing or *SignerOptions is supported")
+	}
+	if prehash && len(digest) != sha512.Size {
+		return nil, errors.New("dilithium: prehash digest must be SHA-512 (64 bytes)")
+	}
+	return SignContext(priv.Bytes, digest, ctx), nil
+}
@@ -0,0 +1,64 @@
+package dilithium
+
+import (
+	"crypto"
+	"testing"
+)
+
+func TestSignContextRoundTrip(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	pk, _ := Gen(make([]byte, 32))
+	msg := []byte("hello")
+	ctx := []byte("app-v1")
+
+	sig := SignContext(sk, msg, ctx)
+	if !VerifyContext(pk, msg, sig, ctx) {
+		t.Fatal("VerifyContext rejected a signature made with the same context")
+	}
+	if VerifyContext(pk, msg, sig, []byte("app-v2")) {
+		t.Fatal("VerifyContext accepted a signature made with a different context")
+	}
+	if VerifyContext(pk, msg, sig, nil) {
+		t.Fatal("VerifyContext accepted a ctx-bound signature with no context")
+	}
+}
+
+func TestSignMatchesSignContextEmpty(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	msg := []byte("test")
+	if string(Sign(sk, msg)) != string(SignContext(sk, msg, nil)) {
+		t.Fatal("Sign should be equivalent to SignContext with no context")
+	}
+}
+
+func TestPrivateKeyImplementsCryptoSigner(t *testing.T) {
+	var _ crypto.Signer = (*PrivateKey)(nil)
+
+	priv := NewPrivateKey(make([]byte, 32))
+	msg := []byte("signer interface")
+	sig, err := priv.Sign(nil, msg, &SignerOptions{Context: []byte("ctx")})
+	if err != nil {
+		t.Fatalf("Sign returned error: %v", err)
+	}
+	pub := priv.Public().(*PublicKey)
+	if !VerifyContext(pub.Bytes, msg, sig, []byte("ctx")) {
+		t.Fatal("signature from crypto.Signer.Sign did not verify")
+	}
+}
+
+func TestPrivateKeyMarshalRoundTrip(t *testing.T) {
+	priv := NewPrivateKey(make([]byte, 32))
+	data, err := priv.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary: %v", err)
+	}
+	var priv2 PrivateKey
+	if err := priv2.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary: %v", err)
+	}
+	pub1 := priv.Public().(*PublicKey)
+	pub2 := priv2.Public().(*PublicKey)
+	if !pub1.Equal(pub2) {
+		t.Fatal("recovered public key does not match original")
+	}
+}
@@ -0,0 +1,98 @@
+package dilithium
+
+import (
+	"zkdilithium-signer/pkg/encoding"
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/hash"
+	"zkdilithium-signer/pkg/poly"
+)
+
+// Signer incrementally signs a message too large to hold in memory: write
+// it in chunks via io.Writer, then call Finalize once for the signature.
+// It absorbs each chunk into the same hMu Poseidon transcript and rho2
+// digest Sign would compute over the whole message at once, so
+// Finalize's signature verifies exactly as if Sign(sk, msg) had been
+// called with every written chunk concatenated. A Signer must not be
+// reused after Finalize.
+type Signer struct {
+	key      []byte
+	s1Hat    [field.L]poly.Poly
+	s2Hat    [field.K]poly.Poly
+	Ahat     [field.K][field.L]poly.Poly
+	hMu      *hash.Poseidon
+	rho2Hash *hash.StreamingH
+	fes      encoding.BytesToFesStreamer
+}
+
+// NewSigner starts a streaming signature over sk, absorbing tr into the
+// transcript up front exactly as computeMu/deriveRho2 do.
+func NewSigner(sk []byte) *Signer {
+	rho, key, tr, s1Hat, s2Hat := unpackSecretKey(sk)
+	Ahat := expandMatrixMont(rho)
+
+	hMu := hash.NewPoseidon([]uint32{0})
+	hMu.Write(encoding.BytesToFes(tr))
+	hMu.Permute()
+
+	rho2Hash := hash.NewStreamingH()
+	rho2Hash.Write(tr)
+
+	return &Signer{key: key, s1Hat: s1Hat, s2Hat: s2Hat, Ahat: Ahat, hMu: hMu, rho2Hash: rho2Hash}
+}
+
+// Write absorbs the next chunk of the message. It never errors.
+func (s *Signer) Write(p []byte) (int, error) {
+	s.hMu.Write(s.fes.Write(p))
+	s.rho2Hash.Write(p)
+	return len(p), nil
+}
+
+// Finalize computes mu and rho2 from everything written so far and returns
+// the signature, running the same rejection-sampling loop Sign uses.
+func (s *Signer) Finalize() []byte {
+	if fe := s.fes.Finish(); fe != nil {
+		s.hMu.Write(fe)
+	}
+	mu := s.hMu.Read(field.MuSize)
+	innerHash := s.rho2Hash.Sum(64)
+	rho2 := deriveRho2FromDigest(s.key, innerHash, nil)
+	return signLoop(s.s1Hat, s.s2Hat, s.Ahat, mu, rho2)
+}
+
+// Verifier incrementally verifies a signature against a message written
+// via io.Writer, mirroring Signer on the verification side.
+type Verifier struct {
+	tHat [field.K]poly.Poly
+	Ahat [field.K][field.L]poly.Poly
+	hMu  *hash.Poseidon
+	fes  encoding.BytesToFesStreamer
+	sig  []byte
+}
+
+// NewVerifier starts a streaming verification of sig against pk.
+func NewVerifier(pk, sig []byte) *Verifier {
+	rho, tr, tHat := unpackPublicKey(pk)
+	Ahat := expandMatrixMont(rho)
+
+	hMu := hash.NewPoseidon([]uint32{0})
+	hMu.Write(encoding.BytesToFes(tr))
+	hMu.Permute()
+
+	return &Verifier{tHat: tHat, Ahat: Ahat, hMu: hMu, sig: sig}
+}
+
+// Write absorbs the next chunk of the message. It never errors.
+func (v *Verifier) Write(p []byte) (int, error) {
+	v.hMu.Write(v.fes.Write(p))
+	return len(p), nil
+}
+
+// Finalize computes mu from everything written so far and reports whether
+// sig verifies against it.
+func (v *Verifier) Finalize() bool {
+	if fe := v.fes.Finish(); fe != nil {
+		v.hMu.Write(fe)
+	}
+	mu := v.hMu.Read(field.MuSize)
+	return verifyLoop(v.tHat, v.Ahat, mu, v.sig)
+}
@@ -0,0 +1,76 @@
+package dilithium
+
+import "testing"
+
+// TestSignerMatchesSign checks a Signer fed a message in several chunks
+// produces the same signature Sign would over the whole message at once.
+func TestSignerMatchesSign(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	msg := []byte("this message is streamed in pieces")
+
+	want := Sign(sk, msg)
+
+	s := NewSigner(sk)
+	s.Write(msg[:10])
+	s.Write(msg[10:23])
+	s.Write(msg[23:])
+	got := s.Finalize()
+
+	if string(got) != string(want) {
+		t.Error("Signer.Finalize did not match Sign over the same message")
+	}
+}
+
+// TestSignerOddLengthChunks exercises the BytesToFesStreamer odd-byte
+// carry across chunk boundaries that don't align with 2-byte pairs.
+func TestSignerOddLengthChunks(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	msg := []byte("odd-length chunks stress the byte-pair carry")
+
+	want := Sign(sk, msg)
+
+	s := NewSigner(sk)
+	for _, n := range []int{1, 3, 1, 5, 7} {
+		s.Write(msg[:n])
+		msg = msg[n:]
+	}
+	s.Write(msg)
+	got := s.Finalize()
+
+	if string(got) != string(want) {
+		t.Error("Signer.Finalize did not match Sign with odd-length chunk writes")
+	}
+}
+
+// TestVerifierMatchesVerify checks a Verifier fed a message in chunks
+// agrees with Verify over the whole message.
+func TestVerifierMatchesVerify(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	msg := []byte("this message is streamed in pieces")
+	sig := Sign(sk, msg)
+
+	if !Verify(pk, msg, sig) {
+		t.Fatal("Verify rejected the reference signature")
+	}
+
+	v := NewVerifier(pk, sig)
+	v.Write(msg[:10])
+	v.Write(msg[10:23])
+	v.Write(msg[23:])
+	if !v.Finalize() {
+		t.Error("Verifier.Finalize rejected a signature Verify accepts")
+	}
+}
+
+// TestVerifierRejectsTamperedChunk checks a Verifier catches a message
+// that differs from what was signed even when fed in chunks.
+func TestVerifierRejectsTamperedChunk(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	sig := Sign(sk, []byte("original message"))
+
+	v := NewVerifier(pk, sig)
+	v.Write([]byte("tampered message"))
+	if v.Finalize() {
+		t.Error("Verifier.Finalize accepted a tampered message")
+	}
+}
@@ -0,0 +1,146 @@
+package dilithium
+
+import (
+	"zkdilithium-signer/pkg/dilithium/witness"
+	"zkdilithium-signer/pkg/encoding"
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/hash"
+	"zkdilithium-signer/pkg/poly"
+	"zkdilithium-signer/pkg/sampling"
+)
+
+// SignWithWitness signs msg exactly as SignContext(sk, msg, nil) does, but
+// additionally returns a witness.Witness recording every intermediate
+// value from the accepted rejection-sampling iteration, for a SNARK
+// circuit checking the signature to constrain against. When trace is
+// true, w.Rejections counts the rejected iterations that preceded the
+// accepted one, for benchmarking rejection-sampling cost across
+// parameter tweaks; when false, w.Rejections is left at zero to avoid
+// paying for the counter on the hot signing path.
+func SignWithWitness(sk, msg []byte, trace bool) (sig []byte, w *witness.Witness) {
+	rho, key, tr, s1Hat, s2Hat := unpackSecretKey(sk)
+	Ahat := expandMatrixMont(rho)
+	rho2 := deriveRho2(key, tr, msg, nil)
+
+	hMu := hash.NewPoseidon([]uint32{0})
+	hMu.Write(encoding.BytesToFes(tr))
+	hMu.Permute()
+	hMu.Write(encoding.BytesToFes(msg))
+	mu := hMu.Read(field.MuSize)
+	muState := *hMu.State()
+
+	yNonce := 0
+	rejections := 0
+	for {
+		y := sampling.SampleY(rho2, yNonce)
+		yNonce += field.L
+		var yHat [field.L]poly.Poly
+		for i := 0; i < field.L; i++ {
+			yHat[i] = y[i]
+			yHat[i].NTT()
+		}
+
+		var w0 [field.K]poly.Poly
+		poly.MatVecMulNTTLazy(&Ahat, &yHat, &w0)
+		for i := 0; i < field.K; i++ {
+			w0[i].InvNTT()
+		}
+
+		var w1 [field.K]poly.Poly
+		for i := 0; i < field.K; i++ {
+			_, w1[i] = w0[i].Decompose()
+		}
+
+		hC := hash.NewPoseidon(nil)
+		hC.Write(mu)
+		for j := 0; j < field.N; j++ {
+			for i := 0; i < field.K; i++ {
+				hC.Write([]uint32{w1[i][j]})
+			}
+		}
+		cTilde := hC.Read(field.CSize)
+		cTildeState := *hC.State()
+
+		hBall := hash.NewPoseidon(append([]uint32{2}, cTilde...))
+		c := sampling.SampleInBall(hBall)
+		if c == nil {
+			rejections++
+			continue
+		}
+
+		var cHat poly.Poly = *c
+		cHat.NTT()
+
+		var cs2 [field.K]poly.Poly
+		for i := 0; i < field.K; i++ {
+			poly.MulNTT(&cHat, &s2Hat[i], &cs2[i])
+			cs2[i].InvNTT()
+		}
+
+		var r0 [field.K]poly.Poly
+		for i := 0; i < field.K; i++ {
+			poly.Sub(&w0[i], &cs2[i], &r0[i])
+		}
+
+		r0Decomposed := make([][field.N]uint32, field.K)
+		for i := 0; i < field.K; i++ {
+			r0Decomposed[i], _ = r0[i].Decompose()
+		}
+
+		var maxR0Norm uint32
+		for i := 0; i < field.K; i++ {
+			var p poly.Poly = r0Decomposed[i]
+			n := p.Norm()
+			if n > maxR0Norm {
+				maxR0Norm = n
+			}
+		}
+		if maxR0Norm >= field.Gamma2-field.Beta {
+			rejections++
+			continue
+		}
+
+		var cs1 [field.L]poly.Poly
+		var z [field.L]poly.Poly
+		for i := 0; i < field.L; i++ {
+			poly.MulNTT(&cHat, &s1Hat[i], &cs1[i])
+			cs1[i].InvNTT()
+			poly.Add(&y[i], &cs1[i], &z[i])
+		}
+
+		var maxZNorm uint32
+		for i := 0; i < field.L; i++ {
+			n := z[i].Norm()
+			if n > maxZNorm {
+				maxZNorm = n
+			}
+		}
+		if maxZNorm >= field.Gamma1-field.Beta {
+			rejections++
+			continue
+		}
+
+		sig := encoding.PackFes(cTilde)
+		for i := 0; i < field.L; i++ {
+			sig = append(sig, encoding.PackPolyLeGamma1((*[field.N]uint32)(&z[i]))...)
+		}
+
+		w = &witness.Witness{
+			Y:           y,
+			W:           w0,
+			W1:          w1,
+			R0:          r0,
+			C:           *c,
+			CS1:         cs1,
+			CS2:         cs2,
+			Z:           z,
+			MuState:     muState,
+			CTildeState: cTildeState,
+			YNonce:      yNonce - field.L,
+		}
+		if trace {
+			w.Rejections = rejections
+		}
+		return sig, w
+	}
+}
@@ -0,0 +1,73 @@
+// Package witness exposes the intermediate values from one accepted
+// iteration of zkDilithium's signing rejection-sampling loop, in the
+// shape a SNARK circuit (gnark, circom) checking a signature needs to
+// constrain against.
+package witness
+
+import (
+	"encoding/json"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/poly"
+)
+
+// Witness holds the accepted y, w, w1, r0, c, cs1, cs2, z from one
+// signing attempt, plus the Poseidon sponge states mu and cTilde were
+// squeezed from and the yNonce that produced y. Every poly.Poly field is
+// in normal (non-Montgomery, non-NTT) form, matching what a circuit
+// checking the arithmetic directly would want. Field names are stable;
+// MarshalJSON pins the wire encoding independently of any future Go
+// field renames.
+type Witness struct {
+	Y           [field.L]poly.Poly
+	W           [field.K]poly.Poly
+	W1          [field.K]poly.Poly
+	R0          [field.K]poly.Poly
+	C           poly.Poly
+	CS1         [field.L]poly.Poly
+	CS2         [field.K]poly.Poly
+	Z           [field.L]poly.Poly
+	MuState     [field.PosT]uint32
+	CTildeState [field.PosT]uint32
+	YNonce      int
+	// Rejections is the number of rejected iterations that preceded this
+	// one, populated only when the caller asked to trace rejections (see
+	// dilithium.SignWithWitness). Zero otherwise.
+	Rejections int
+}
+
+// jsonWitness gives every field a lowercase name for gnark/circom
+// front-ends that ingest this JSON directly, independently of Witness's
+// Go field names.
+type jsonWitness struct {
+	Y           [field.L]poly.Poly `json:"y"`
+	W           [field.K]poly.Poly `json:"w"`
+	W1          [field.K]poly.Poly `json:"w1"`
+	R0          [field.K]poly.Poly `json:"r0"`
+	C           poly.Poly          `json:"c"`
+	CS1         [field.L]poly.Poly `json:"cs1"`
+	CS2         [field.K]poly.Poly `json:"cs2"`
+	Z           [field.L]poly.Poly `json:"z"`
+	MuState     [field.PosT]uint32 `json:"muState"`
+	CTildeState [field.PosT]uint32 `json:"cTildeState"`
+	YNonce      int                `json:"yNonce"`
+	Rejections  int                `json:"rejections"`
+}
+
+// MarshalJSON encodes w for hand-off to a gnark/circom front-end.
+func (w *Witness) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonWitness{
+		Y:           w.Y,
+		W:           w.W,
+		W1:          w.W1,
+		R0:          w.R0,
+		C:           w.C,
+		CS1:         w.CS1,
+		CS2:         w.CS2,
+		Z:           w.Z,
+		MuState:     w.MuState,
+		CTildeState: w.CTildeState,
+		YNonce:      w.YNonce,
+		Rejections:  w.Rejections,
+	})
+}
@@ -0,0 +1,41 @@
+package dilithium
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSignWithWitnessSigVerifies checks the packed signature returned
+// alongside the witness verifies normally.
+func TestSignWithWitnessSigVerifies(t *testing.T) {
+	pk, sk := Gen(make([]byte, 32))
+	msg := []byte("witnessed message")
+
+	sig, w := SignWithWitness(sk, msg, false)
+	if !Verify(pk, msg, sig) {
+		t.Error("Verify rejected a SignWithWitness signature")
+	}
+	if w == nil {
+		t.Fatal("SignWithWitness returned a nil witness")
+	}
+	if w.Rejections != 0 {
+		t.Errorf("Rejections = %d without tracing, want 0", w.Rejections)
+	}
+}
+
+// TestSignWithWitnessMarshalsJSON checks the witness round-trips through
+// its MarshalJSON without error and includes the expected field names.
+func TestSignWithWitnessMarshalsJSON(t *testing.T) {
+	_, sk := Gen(make([]byte, 32))
+	_, w := SignWithWitness(sk, []byte("test"), true)
+
+	b, err := w.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON: %v", err)
+	}
+	for _, key := range []string{`"y"`, `"w"`, `"w1"`, `"r0"`, `"c"`, `"cs1"`, `"cs2"`, `"z"`, `"muState"`, `"cTildeState"`, `"yNonce"`, `"rejections"`} {
+		if !strings.Contains(string(b), key) {
+			t.Errorf("MarshalJSON output missing key %s", key)
+		}
+	}
+}
@@ -47,6 +47,53 @@ func BytesToFes(bs []byte) []uint32 {
 	return result
 }
 
+// BytesToFesStreamer packs bytes into field elements the same way
+// BytesToFes does, but incrementally: repeated Write calls behave as if
+// their inputs had been concatenated and passed to BytesToFes once,
+// including the odd-length zero pad, which BytesToFesStreamer only
+// applies once Finish is called. This lets a caller absorb bytes into a
+// Poseidon sponge (or anything else that wants field elements) as they
+// arrive, without buffering the whole message.
+type BytesToFesStreamer struct {
+	pending    uint32
+	hasPending bool
+}
+
+// Write returns the field elements packable from bs given any byte left
+// over from a previous Write, buffering a new trailing odd byte if the
+// combined (pending + bs) length is odd.
+func (s *BytesToFesStreamer) Write(bs []byte) []uint32 {
+	modified := make([]uint32, 0, len(bs)+1)
+	if s.hasPending {
+		modified = append(modified, s.pending)
+		s.hasPending = false
+	}
+	for _, b := range bs {
+		modified = append(modified, uint32(b)+1)
+	}
+	if len(modified)%2 == 1 {
+		s.pending = modified[len(modified)-1]
+		s.hasPending = true
+		modified = modified[:len(modified)-1]
+	}
+	result := make([]uint32, len(modified)/2)
+	for i := range result {
+		result[i] = modified[2*i] + 257*modified[2*i+1]
+	}
+	return result
+}
+
+// Finish returns the final field element from any buffered odd byte,
+// zero-padded exactly as BytesToFes pads a whole odd-length input, or nil
+// if the total input length was even.
+func (s *BytesToFesStreamer) Finish() []uint32 {
+	if !s.hasPending {
+		return nil
+	}
+	s.hasPending = false
+	return []uint32{s.pending}
+}
+
 // PackPoly packs a polynomial (256 coefficients) into bytes.
 func PackPoly(cs *[field.N]uint32) []byte {
 	return PackFes(cs[:])
@@ -60,6 +107,29 @@ func UnpackPoly(bs []byte) [field.N]uint32 {
 	return result
 }
 
+// packLeqEtaGroup packs 8 already-converted ([0, 2*Eta]) coefficients into
+// their 3-byte group, the packing granularity PackPolyLeqEta operates on.
+// Factored out so the streaming encoder in streaming.go can produce the
+// same bytes one group at a time.
+func packLeqEtaGroup(cs *[8]uint32, out *[3]byte) {
+	out[0] = byte(cs[0]) | byte(cs[1]<<3) | byte((cs[2]<<6)&0xFF)
+	out[1] = byte(cs[2]>>2) | byte(cs[3]<<1) | byte(cs[4]<<4) | byte((cs[5]<<7)&0xFF)
+	out[2] = byte(cs[5]>>1) | byte(cs[6]<<2) | byte(cs[7]<<5)
+}
+
+// unpackLeqEtaGroup is packLeqEtaGroup's inverse, producing 8 still-converted
+// ([0, 2*Eta]) coefficients from a 3-byte group.
+func unpackLeqEtaGroup(bs *[3]byte, cs *[8]uint32) {
+	cs[0] = uint32(bs[0] & 7)
+	cs[1] = uint32((bs[0] >> 3) & 7)
+	cs[2] = uint32((bs[0] >> 6) | ((bs[1] << 2) & 7))
+	cs[3] = uint32((bs[1] >> 1) & 7)
+	cs[4] = uint32((bs[1] >> 4) & 7)
+	cs[5] = uint32((bs[1] >> 7) | ((bs[2] << 1) & 7))
+	cs[6] = uint32((bs[2] >> 2) & 7)
+	cs[7] = uint32((bs[2] >> 5) & 7)
+}
+
 // PackPolyLeqEta packs a polynomial with coefficients in [-Eta, Eta].
 // Uses 3 bits per coefficient (8 coefficients per 3 bytes).
 func PackPolyLeqEta(cs *[field.N]uint32) []byte {
@@ -73,9 +143,11 @@ func PackPolyLeqEta(cs *[field.N]uint32) []byte {
 
 	for i := 0; i < 256; i += 8 {
 		j := i / 8 * 3
-		result[j] = byte(converted[i]) | byte(converted[i+1]<<3) | byte((converted[i+2]<<6)&0xFF)
-		result[j+1] = byte(converted[i+2]>>2) | byte(converted[i+3]<<1) | byte(converted[i+4]<<4) | byte((converted[i+5]<<7)&0xFF)
-		result[j+2] = byte(converted[i+5]>>1) | byte(converted[i+6]<<2) | byte(converted[i+7]<<5)
+		var group [8]uint32
+		copy(group[:], converted[i:i+8])
+		var out [3]byte
+		packLeqEtaGroup(&group, &out)
+		copy(result[j:j+3], out[:])
 	}
 	return result
 }
@@ -85,14 +157,11 @@ func UnpackPolyLeqEta(bs []byte) [field.N]uint32 {
 	var result [field.N]uint32
 	idx := 0
 	for i := 0; i < 96; i += 3 {
-		result[idx] = uint32(bs[i] & 7)
-		result[idx+1] = uint32((bs[i] >> 3) & 7)
-		result[idx+2] = uint32((bs[i]>>6)|((bs[i+1]<<2)&7))
-		result[idx+3] = uint32((bs[i+1] >> 1) & 7)
-		result[idx+4] = uint32((bs[i+1] >> 4) & 7)
-		result[idx+5] = uint32((bs[i+1]>>7)|((bs[i+2]<<1)&7))
-		result[idx+6] = uint32((bs[i+2] >> 2) & 7)
-		result[idx+7] = uint32((bs[i+2] >> 5) & 7)
+		var group [8]uint32
+		var in [3]byte
+		copy(in[:], bs[i:i+3])
+		unpackLeqEtaGroup(&in, &group)
+		copy(result[idx:idx+8], group[:])
 		idx += 8
 	}
 
@@ -103,6 +172,32 @@ func UnpackPolyLeqEta(bs []byte) [field.N]uint32 {
 	return result
 }
 
+// packLeGamma1Group packs 4 already-converted ([0, 2*Gamma1]) coefficients
+// into their 9-byte group, the packing granularity PackPolyLeGamma1 operates
+// on. Factored out so the streaming encoder in streaming.go can produce the
+// same bytes one group at a time.
+func packLeGamma1Group(cs *[4]uint32, out *[9]byte) {
+	c0, c1, c2, c3 := cs[0], cs[1], cs[2], cs[3]
+	out[0] = byte(c0 & 0xFF)
+	out[1] = byte((c0 >> 8) & 0xFF)
+	out[2] = byte((c0 >> 16) | ((c1 << 2) & 0xFF))
+	out[3] = byte((c1 >> 6) & 0xFF)
+	out[4] = byte((c1 >> 14) | ((c2 << 4) & 0xFF))
+	out[5] = byte((c2 >> 4) & 0xFF)
+	out[6] = byte((c2 >> 12) | ((c3 << 6) & 0xFF))
+	out[7] = byte((c3 >> 2) & 0xFF)
+	out[8] = byte((c3 >> 10) & 0xFF)
+}
+
+// unpackLeGamma1Group is packLeGamma1Group's inverse, producing 4
+// still-converted ([0, 2*Gamma1]) coefficients from a 9-byte group.
+func unpackLeGamma1Group(bs *[9]byte, cs *[4]uint32) {
+	cs[0] = uint32(bs[0]) | (uint32(bs[1]) << 8) | ((uint32(bs[2]) & 0x3) << 16)
+	cs[1] = (uint32(bs[2]) >> 2) | (uint32(bs[3]) << 6) | ((uint32(bs[4]) & 0xF) << 14)
+	cs[2] = (uint32(bs[4]) >> 4) | (uint32(bs[5]) << 4) | ((uint32(bs[6]) & 0x3F) << 12)
+	cs[3] = (uint32(bs[6]) >> 6) | (uint32(bs[7]) << 2) | (uint32(bs[8]) << 10)
+}
+
 // PackPolyLeGamma1 packs a polynomial with coefficients in [-Gamma1+1, Gamma1].
 // Uses 18 bits per coefficient (4 coefficients per 9 bytes).
 func PackPolyLeGamma1(cs *[field.N]uint32) []byte {
@@ -110,21 +205,17 @@ func PackPolyLeGamma1(cs *[field.N]uint32) []byte {
 
 	for i := 0; i < 256; i += 4 {
 		// Convert to [0, 2*Gamma1] range
-		c0 := field.Sub(field.Gamma1, cs[i])
-		c1 := field.Sub(field.Gamma1, cs[i+1])
-		c2 := field.Sub(field.Gamma1, cs[i+2])
-		c3 := field.Sub(field.Gamma1, cs[i+3])
+		group := [4]uint32{
+			field.Sub(field.Gamma1, cs[i]),
+			field.Sub(field.Gamma1, cs[i+1]),
+			field.Sub(field.Gamma1, cs[i+2]),
+			field.Sub(field.Gamma1, cs[i+3]),
+		}
 
 		j := i / 4 * 9
-		result[j] = byte(c0 & 0xFF)
-		result[j+1] = byte((c0 >> 8) & 0xFF)
-		result[j+2] = byte((c0 >> 16) | ((c1 << 2) & 0xFF))
-		result[j+3] = byte((c1 >> 6) & 0xFF)
-		result[j+4] = byte((c1 >> 14) | ((c2 << 4) & 0xFF))
-		result[j+5] = byte((c2 >> 4) & 0xFF)
-		result[j+6] = byte((c2 >> 12) | ((c3 << 6) & 0xFF))
-		result[j+7] = byte((c3 >> 2) & 0xFF)
-		result[j+8] = byte((c3 >> 10) & 0xFF)
+		var out [9]byte
+		packLeGamma1Group(&group, &out)
+		copy(result[j:j+9], out[:])
 	}
 	return result
 }
@@ -134,16 +225,16 @@ func UnpackPolyLeGamma1(bs []byte) [field.N]uint32 {
 	var result [field.N]uint32
 
 	for i := 0; i < 64*9; i += 9 {
-		c0 := uint32(bs[i]) | (uint32(bs[i+1]) << 8) | ((uint32(bs[i+2]) & 0x3) << 16)
-		c1 := (uint32(bs[i+2]) >> 2) | (uint32(bs[i+3]) << 6) | ((uint32(bs[i+4]) & 0xF) << 14)
-		c2 := (uint32(bs[i+4]) >> 4) | (uint32(bs[i+5]) << 4) | ((uint32(bs[i+6]) & 0x3F) << 12)
-		c3 := (uint32(bs[i+6]) >> 6) | (uint32(bs[i+7]) << 2) | (uint32(bs[i+8]) << 10)
+		var in [9]byte
+		copy(in[:], bs[i:i+9])
+		var group [4]uint32
+		unpackLeGamma1Group(&in, &group)
 
 		idx := (i / 9) * 4
-		result[idx] = field.Mod(int64(field.Gamma1) - int64(c0))
-		result[idx+1] = field.Mod(int64(field.Gamma1) - int64(c1))
-		result[idx+2] = field.Mod(int64(field.Gamma1) - int64(c2))
-		result[idx+3] = field.Mod(int64(field.Gamma1) - int64(c3))
+		result[idx] = field.Mod(int64(field.Gamma1) - int64(group[0]))
+		result[idx+1] = field.Mod(int64(field.Gamma1) - int64(group[1]))
+		result[idx+2] = field.Mod(int64(field.Gamma1) - int64(group[2]))
+		result[idx+3] = field.Mod(int64(field.Gamma1) - int64(group[3]))
 	}
 	return result
 }
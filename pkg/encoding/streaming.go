@@ -0,0 +1,192 @@
+package encoding
+
+import (
+	"errors"
+	"io"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+// Sizer reports the exact packed byte length of encodings in this package
+// without allocating, so callers can size a network buffer up front
+// (mirroring how streaming encoders in crypto/cipher and golang.org/x/crypto
+// let callers write directly into caller-owned memory).
+type Sizer struct{}
+
+// FesBytes returns the packed size of n field elements (PackFes/UnpackFes).
+func (Sizer) FesBytes(n int) int { return n * 3 }
+
+// PolyBytes returns the packed size of a full polynomial (PackPoly).
+func (Sizer) PolyBytes() int { return field.N * 3 }
+
+// PolyLeqEtaBytes returns the packed size of a PackPolyLeqEta polynomial.
+func (Sizer) PolyLeqEtaBytes() int { return field.N * 3 / 8 }
+
+// PolyLeGamma1Bytes returns the packed size of a PackPolyLeGamma1 polynomial.
+func (Sizer) PolyLeGamma1Bytes() int { return field.PolyLeGamma1Size }
+
+// PackFesTo writes packed field elements directly to w, avoiding the
+// intermediate full-size allocation PackFes makes.
+func PackFesTo(w io.Writer, fes []uint32) (int, error) {
+	var buf [3]byte
+	total := 0
+	for _, c := range fes {
+		buf[0] = byte(c & 0xFF)
+		buf[1] = byte((c >> 8) & 0xFF)
+		buf[2] = byte(c >> 16)
+		n, err := w.Write(buf[:])
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// UnpackFesFrom reads n packed field elements from r.
+func UnpackFesFrom(r io.Reader, n int) ([]uint32, error) {
+	result := make([]uint32, n)
+	var buf [3]byte
+	for i := 0; i < n; i++ {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return nil, err
+		}
+		result[i] = (uint32(buf[0]) | uint32(buf[1])<<8 | uint32(buf[2])<<16) % field.Q
+	}
+	return result, nil
+}
+
+// errPartialGroup is returned by Flush when a streaming encoder has
+// buffered coefficients that don't yet fill a whole packing group (8
+// coefficients for LeqEta, 4 for LeGamma1) — those packings aren't
+// byte-aligned at coarser granularity, so a partial group cannot be
+// flushed without either padding (which would corrupt decoding) or
+// blocking until more input arrives.
+var errPartialGroup = errors.New("encoding: streaming encoder flushed with a partial coefficient group pending")
+
+// PolyLeqEtaEncoder streams PackPolyLeqEta's encoding without requiring the
+// full 256-coefficient polynomial up front. Coefficients may be written in
+// any group size; output is produced every time 8 coefficients have
+// accumulated (PackPolyLeqEta's own packing granularity).
+type PolyLeqEtaEncoder struct {
+	w   io.Writer
+	buf [8]uint32
+	n   int
+}
+
+// NewPolyLeqEtaEncoder creates a streaming LeqEta encoder writing to w.
+func NewPolyLeqEtaEncoder(w io.Writer) *PolyLeqEtaEncoder {
+	return &PolyLeqEtaEncoder{w: w}
+}
+
+// Write appends coefficients, flushing complete 8-coefficient groups to w.
+func (e *PolyLeqEtaEncoder) Write(cs []uint32) error {
+	for _, c := range cs {
+		e.buf[e.n] = field.Sub(field.Eta, c)
+		e.n++
+		if e.n == 8 {
+			var out [3]byte
+			packLeqEtaGroup(&e.buf, &out)
+			if _, err := e.w.Write(out[:]); err != nil {
+				return err
+			}
+			e.n = 0
+		}
+	}
+	return nil
+}
+
+// Flush reports an error if a partial (non-multiple-of-8) group is still
+// buffered; it exists so callers that stream a polynomial in uneven chunks
+// get an explicit signal instead of silently dropping coefficients.
+func (e *PolyLeqEtaEncoder) Flush() error {
+	if e.n != 0 {
+		return errPartialGroup
+	}
+	return nil
+}
+
+// PolyLeqEtaDecoder streams UnpackPolyLeqEta's decoding, producing 8
+// coefficients per 3 bytes read.
+type PolyLeqEtaDecoder struct {
+	r io.Reader
+}
+
+// NewPolyLeqEtaDecoder creates a streaming LeqEta decoder reading from r.
+func NewPolyLeqEtaDecoder(r io.Reader) *PolyLeqEtaDecoder { return &PolyLeqEtaDecoder{r: r} }
+
+// ReadGroup reads the next 8 coefficients.
+func (d *PolyLeqEtaDecoder) ReadGroup() ([8]uint32, error) {
+	var bs [3]byte
+	var out [8]uint32
+	if _, err := io.ReadFull(d.r, bs[:]); err != nil {
+		return out, err
+	}
+	unpackLeqEtaGroup(&bs, &out)
+	for i, c := range out {
+		out[i] = field.Mod(int64(field.Eta) - int64(c))
+	}
+	return out, nil
+}
+
+// PolyLeGamma1Encoder streams PackPolyLeGamma1's encoding, producing 9
+// bytes per 4 coefficients written.
+type PolyLeGamma1Encoder struct {
+	w   io.Writer
+	buf [4]uint32
+	n   int
+}
+
+// NewPolyLeGamma1Encoder creates a streaming LeGamma1 encoder writing to w.
+func NewPolyLeGamma1Encoder(w io.Writer) *PolyLeGamma1Encoder {
+	return &PolyLeGamma1Encoder{w: w}
+}
+
+// Write appends coefficients, flushing complete 4-coefficient groups to w.
+func (e *PolyLeGamma1Encoder) Write(cs []uint32) error {
+	for _, c := range cs {
+		e.buf[e.n] = field.Sub(field.Gamma1, c)
+		e.n++
+		if e.n == 4 {
+			var out [9]byte
+			packLeGamma1Group(&e.buf, &out)
+			if _, err := e.w.Write(out[:]); err != nil {
+				return err
+			}
+			e.n = 0
+		}
+	}
+	return nil
+}
+
+// Flush reports an error if a partial (non-multiple-of-4) group is pending.
+func (e *PolyLeGamma1Encoder) Flush() error {
+	if e.n != 0 {
+		return errPartialGroup
+	}
+	return nil
+}
+
+// PolyLeGamma1Decoder streams UnpackPolyLeGamma1's decoding.
+type PolyLeGamma1Decoder struct {
+	r io.Reader
+}
+
+// NewPolyLeGamma1Decoder creates a streaming LeGamma1 decoder reading from r.
+func NewPolyLeGamma1Decoder(r io.Reader) *PolyLeGamma1Decoder {
+	return &PolyLeGamma1Decoder{r: r}
+}
+
+// ReadGroup reads the next 4 coefficients.
+func (d *PolyLeGamma1Decoder) ReadGroup() ([4]uint32, error) {
+	var bs [9]byte
+	var out [4]uint32
+	if _, err := io.ReadFull(d.r, bs[:]); err != nil {
+		return out, err
+	}
+	unpackLeGamma1Group(&bs, &out)
+	for i, c := range out {
+		out[i] = field.Mod(int64(field.Gamma1) - int64(c))
+	}
+	return out, nil
+}
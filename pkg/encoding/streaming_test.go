@@ -0,0 +1,140 @@
+package encoding
+
+import (
+	"bytes"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+func TestSizer(t *testing.T) {
+	var s Sizer
+	if got, want := s.FesBytes(10), 30; got != want {
+		t.Errorf("FesBytes(10) = %d, want %d", got, want)
+	}
+	if got, want := s.PolyBytes(), field.N*3; got != want {
+		t.Errorf("PolyBytes() = %d, want %d", got, want)
+	}
+	if got, want := s.PolyLeqEtaBytes(), 96; got != want {
+		t.Errorf("PolyLeqEtaBytes() = %d, want %d", got, want)
+	}
+	if got, want := s.PolyLeGamma1Bytes(), field.PolyLeGamma1Size; got != want {
+		t.Errorf("PolyLeGamma1Bytes() = %d, want %d", got, want)
+	}
+}
+
+func TestPackFesToMatchesPackFes(t *testing.T) {
+	fes := []uint32{0, 1, 12345, field.Q - 1, 7654321 % field.Q}
+
+	var buf bytes.Buffer
+	n, err := PackFesTo(&buf, fes)
+	if err != nil {
+		t.Fatalf("PackFesTo: %v", err)
+	}
+	if n != len(fes)*3 {
+		t.Fatalf("PackFesTo wrote %d bytes, want %d", n, len(fes)*3)
+	}
+	if !bytes.Equal(buf.Bytes(), PackFes(fes)) {
+		t.Fatalf("PackFesTo output does not match PackFes")
+	}
+
+	got, err := UnpackFesFrom(bytes.NewReader(buf.Bytes()), len(fes))
+	if err != nil {
+		t.Fatalf("UnpackFesFrom: %v", err)
+	}
+	want := UnpackFes(buf.Bytes())
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("index %d: UnpackFesFrom=%d want %d", i, got[i], want[i])
+		}
+	}
+}
+
+func TestPolyLeqEtaStreamingMatchesBatch(t *testing.T) {
+	var cs [field.N]uint32
+	for i := range cs {
+		cs[i] = field.Mod(int64(i%5) - 2) // in [-2, 2] == [-Eta, Eta]
+	}
+	want := PackPolyLeqEta(&cs)
+
+	var buf bytes.Buffer
+	enc := NewPolyLeqEtaEncoder(&buf)
+	// Feed coefficients in uneven chunks to exercise cross-call buffering.
+	if err := enc.Write(cs[:3]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Write(cs[3:100]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Write(cs[100:]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("streaming PolyLeqEta output does not match PackPolyLeqEta")
+	}
+
+	dec := NewPolyLeqEtaDecoder(bytes.NewReader(buf.Bytes()))
+	wantUnpacked := UnpackPolyLeqEta(want)
+	for g := 0; g < field.N/8; g++ {
+		group, err := dec.ReadGroup()
+		if err != nil {
+			t.Fatalf("ReadGroup: %v", err)
+		}
+		for i, c := range group {
+			if c != wantUnpacked[g*8+i] {
+				t.Fatalf("group %d index %d: got %d want %d", g, i, c, wantUnpacked[g*8+i])
+			}
+		}
+	}
+}
+
+func TestPolyLeqEtaEncoderFlushPartial(t *testing.T) {
+	var buf bytes.Buffer
+	enc := NewPolyLeqEtaEncoder(&buf)
+	if err := enc.Write([]uint32{0, 1, 2}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Flush(); err == nil {
+		t.Fatal("Flush: expected error for partial group, got nil")
+	}
+}
+
+func TestPolyLeGamma1StreamingMatchesBatch(t *testing.T) {
+	var cs [field.N]uint32
+	for i := range cs {
+		cs[i] = field.Mod(int64(i) - int64(field.N/2))
+	}
+	want := PackPolyLeGamma1(&cs)
+
+	var buf bytes.Buffer
+	enc := NewPolyLeGamma1Encoder(&buf)
+	if err := enc.Write(cs[:10]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Write(cs[10:]); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := enc.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Fatalf("streaming PolyLeGamma1 output does not match PackPolyLeGamma1")
+	}
+
+	dec := NewPolyLeGamma1Decoder(bytes.NewReader(buf.Bytes()))
+	wantUnpacked := UnpackPolyLeGamma1(want)
+	for g := 0; g < field.N/4; g++ {
+		group, err := dec.ReadGroup()
+		if err != nil {
+			t.Fatalf("ReadGroup: %v", err)
+		}
+		for i, c := range group {
+			if c != wantUnpacked[g*4+i] {
+				t.Fatalf("group %d index %d: got %d want %d", g, i, c, wantUnpacked[g*4+i])
+			}
+		}
+	}
+}
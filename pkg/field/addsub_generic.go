@@ -0,0 +1,33 @@
+//go:build !purego
+
+package field
+
+// Add returns (a + b) mod Q. See addsub_purego.go for the full doc comment.
+//
+// This is the default (!purego) build, and it is identical to the purego
+// reference: no assembly backend (e.g. amd64 ADD+CMOVAE, arm64 ADDS+CSEL
+// folding the conditional subtraction into one instruction) exists yet.
+//
+// Status: the VPMULUDQ/SMULH/UMULH assembly backend this request asked
+// for (for Add, MulMont, and BatchInvMontTreeNoZeroILP4's multiply-heavy
+// passes) is rejected for this environment, not deferred — there's no
+// assembler or way to execute-test hand-written `.s` here.
+func Add(a, b uint32) uint32 {
+	sum := a + b
+	if sum >= Q {
+		sum -= Q
+	}
+	return sum
+}
+
+// Sub returns (a - b) mod Q. See addsub_purego.go for the full doc comment.
+//
+// This is the default (!purego) build; see Add's doc comment above for why
+// it is currently identical to the purego reference.
+func Sub(a, b uint32) uint32 {
+	diff := int32(a) - int32(b)
+	if diff < 0 {
+		diff += Q
+	}
+	return uint32(diff)
+}
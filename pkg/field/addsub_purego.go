@@ -0,0 +1,29 @@
+//go:build purego
+
+package field
+
+// Add returns (a + b) mod Q.
+// Since Q ~ 2^23, a + b < 2*Q < 2^24 fits in uint32.
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// byte-identical to the `!purego` path in addsub_generic.go.
+func Add(a, b uint32) uint32 {
+	sum := a + b
+	if sum >= Q {
+		sum -= Q
+	}
+	return sum
+}
+
+// Sub returns (a - b) mod Q.
+// Using int32 arithmetic avoids extra comparison.
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// byte-identical to the `!purego` path in addsub_generic.go.
+func Sub(a, b uint32) uint32 {
+	diff := int32(a) - int32(b)
+	if diff < 0 {
+		diff += Q
+	}
+	return uint32(diff)
+}
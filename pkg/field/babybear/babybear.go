@@ -0,0 +1,150 @@
+// Package babybear is a second field.Prime backend, over the 31-bit prime
+// BabyBear uses: P = 15*2^27 + 1 = 2013265921. It exists to prove out
+// field.Prime / poly.Ring[F] against a field other than this module's
+// native Q = 7340033 — the motivating case being a zk backend that wants
+// Poseidon and NTT-domain polynomial arithmetic over a prime more common in
+// other proving systems (Plonky3, Risc0) than zkDilithium's own Q.
+//
+// Unlike pkg/field's Q = 7340033 arithmetic, this backend does not carry a
+// hand-optimized Montgomery/Barrett path: P is small enough that a 64-bit
+// product followed by a hardware `%` never leaves the fast integer path,
+// so ToMont/FromMont/MulMont below are the identity and Mul respectively
+// (Montgomery form coincides with normal form, i.e. R = 1). That keeps this
+// package a straightforward correctness reference rather than a second
+// optimization target.
+package babybear
+
+import "zkdilithium-signer/pkg/field"
+
+// P is the BabyBear prime: 15*2^27 + 1.
+const P uint64 = 2013265921
+
+// N is the ring degree this backend's Params() advertises, matching
+// pkg/field.N so poly.Ring[BabyBear] and poly.Ring[field.ZKDilithium] can
+// be exercised with the same-shaped tests.
+const N = 256
+
+// zeta is a primitive 512th root of unity mod P: generator^((P-1)/512),
+// with generator = 31 (a known primitive root of BabyBear's multiplicative
+// group) and (P-1)/512 = 3932160.
+const zeta uint64 = 1753498361
+
+// invZeta is zeta's inverse mod P, computed once at init via Inv(zeta).
+var invZeta = Inv(zeta)
+
+// BabyBear implements field.Prime. It is a zero-size type: all state is
+// either the P/zeta constants above or derived from them on the fly.
+type BabyBear struct{}
+
+// Params returns this field's NTT constants.
+func (BabyBear) Params() field.Params {
+	return field.Params{Q: P, N: N, Zeta: zeta, InvZeta: invZeta}
+}
+
+// Add returns (a + b) mod P.
+func Add(a, b uint64) uint64 {
+	s := a + b
+	if s >= P {
+		s -= P
+	}
+	return s
+}
+
+// Sub returns (a - b) mod P.
+func Sub(a, b uint64) uint64 {
+	if a >= b {
+		return a - b
+	}
+	return P - (b - a)
+}
+
+// Mul returns (a * b) mod P. a, b < P < 2^31, so the product fits in 62
+// bits — no risk of uint64 overflow.
+func Mul(a, b uint64) uint64 {
+	return (a * b) % P
+}
+
+// Neg returns (-a) mod P.
+func Neg(a uint64) uint64 {
+	if a == 0 {
+		return 0
+	}
+	return P - a
+}
+
+// Exp returns a^e mod P using binary exponentiation, mirroring
+// field.Exp's shape.
+func Exp(a, e uint64) uint64 {
+	result := uint64(1)
+	base := a % P
+	for e > 0 {
+		if e&1 == 1 {
+			result = Mul(result, base)
+		}
+		base = Mul(base, base)
+		e >>= 1
+	}
+	return result
+}
+
+// Inv returns the modular inverse of a mod P (0 if a == 0), via Fermat's
+// little theorem: a^(P-2) mod P.
+func Inv(a uint64) uint64 {
+	if a == 0 {
+		return 0
+	}
+	return Exp(a, P-2)
+}
+
+// BatchInv inverts every element of xs in place (0 stays 0), via the same
+// Montgomery's-trick prefix-product xs not to be confused with Montgomery
+// form: it needs only Mul/Inv, no R.
+func BatchInv(xs []uint64) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	prods := make([]uint64, n)
+	prods[0] = xs[0]
+	if prods[0] == 0 {
+		prods[0] = 1
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] == 0 {
+			prods[i] = prods[i-1]
+		} else {
+			prods[i] = Mul(prods[i-1], xs[i])
+		}
+	}
+	inv := Inv(prods[n-1])
+	for i := n - 1; i > 0; i-- {
+		if xs[i] == 0 {
+			continue
+		}
+		old := xs[i]
+		xs[i] = Mul(inv, prods[i-1])
+		inv = Mul(inv, old)
+	}
+	if xs[0] != 0 {
+		xs[0] = inv
+	}
+}
+
+// ToMont is the identity: this backend has no Montgomery form (see the
+// package doc comment).
+func (BabyBear) ToMont(a uint64) uint64 { return a }
+
+// FromMont is the identity, for the same reason.
+func (BabyBear) FromMont(a uint64) uint64 { return a }
+
+// MulMont is plain Mul, since Montgomery form coincides with normal form.
+func (BabyBear) MulMont(a, b uint64) uint64 { return Mul(a, b) }
+
+func (BabyBear) Add(a, b uint64) uint64 { return Add(a, b) }
+func (BabyBear) Sub(a, b uint64) uint64 { return Sub(a, b) }
+func (BabyBear) Mul(a, b uint64) uint64 { return Mul(a, b) }
+func (BabyBear) Neg(a uint64) uint64    { return Neg(a) }
+func (BabyBear) Inv(a uint64) uint64    { return Inv(a) }
+func (BabyBear) BatchInv(xs []uint64)   { BatchInv(xs) }
+
+var _ field.Prime = BabyBear{}
@@ -0,0 +1,216 @@
+package field
+
+import (
+	"runtime"
+	"sync"
+)
+
+// blockPipelineMinN is the smallest len(xs) BatchInvMontBlockParallel
+// bothers splitting into a pipeline for. Below this, a plain BatchInvMontTree
+// call wins outright: goroutine handoff costs more than the tree saves.
+const blockPipelineMinN = 256
+
+// ScratchPool is a sync.Pool of batch-inversion scratch buffers, sized for a
+// particular block. BatchInvMontBlockParallel's workers borrow a buffer per
+// block instead of allocating one, so a hot signing/proving loop that calls
+// it repeatedly does not churn the allocator. A nil *ScratchPool is valid
+// everywhere a *ScratchPool is accepted: BatchInvMontBlockParallel falls
+// back to a fresh make([]uint32, ...) per block in that case.
+type ScratchPool struct {
+	pool sync.Pool
+}
+
+// NewScratchPool returns a ScratchPool whose buffers are sized for blocks of
+// up to blockSize elements (3*blockSize, matching every BatchInvMontTree*
+// scratch contract in this package).
+func NewScratchPool(blockSize int) *ScratchPool {
+	if blockSize < 1 {
+		blockSize = 1
+	}
+	return &ScratchPool{
+		pool: sync.Pool{
+			New: func() any {
+				buf := make([]uint32, 3*blockSize)
+				return &buf
+			},
+		},
+	}
+}
+
+// get returns a scratch buffer with capacity >= 3*n, reusing a pooled one
+// when it's large enough.
+func (p *ScratchPool) get(n int) []uint32 {
+	need := 3 * n
+	if p == nil {
+		return make([]uint32, need)
+	}
+	bufp, _ := p.pool.Get().(*[]uint32)
+	if bufp == nil || cap(*bufp) < need {
+		buf := make([]uint32, need)
+		return buf
+	}
+	return (*bufp)[:need]
+}
+
+// put returns buf to the pool for reuse by a later block.
+func (p *ScratchPool) put(buf []uint32) {
+	if p == nil {
+		return
+	}
+	p.pool.Put(&buf)
+}
+
+// blockJob is one unit of work threaded through BatchInvMontBlockParallel's
+// pipeline: the up-sweep stage hands it to the inversion stage, which hands
+// it to the down-sweep stage.
+type blockJob struct {
+	xs      []uint32 // this block's slice of the caller's xs, in place
+	scratch []uint32 // pooled scratch, len == 3*len(xs)
+	total   uint32   // product of xs, filled in by the up-sweep stage
+}
+
+// BatchInvMontBlockParallel is BatchInvMontTreeConcurrent's counterpart for
+// workloads too large or too latency-sensitive for a single shared tree: it
+// partitions xs into independent blocks of blockSize elements (blockSize<=0
+// or blockSize>=len(xs) means "one block", see below), and runs each
+// block's own batch inversion — up-sweep, root Inv, down-sweep — through a
+// three-stage pipeline instead of one goroutine per block doing all three
+// in sequence:
+//
+//  1. up-sweep workers (a pool of `workers` goroutines) compute each
+//     block's forward prefix products and total, borrowing scratch from
+//     pool.
+//  2. a single inversion stage calls InvMont on each block's total as it
+//     arrives. This is the only step that cannot itself be parallelized,
+//     but because it's decoupled from stages 1 and 3 by channels, the next
+//     block's up-sweep and the previous block's down-sweep both run
+//     concurrently with it — the serial Inv latency is hidden behind other
+//     blocks' useful multiplications rather than stalling the pipeline.
+//  3. down-sweep workers (a second pool of `workers` goroutines) turn each
+//     block's total inverse back into per-element inverses and return the
+//     block's scratch to pool.
+//
+// For a single block (blockSize<=0, blockSize>=len(xs), or len(xs) below
+// blockPipelineMinN) the pipeline is skipped and BatchInvMontBlockParallel
+// makes a plain BatchInvMontTree call — splitting one block into sub-blocks would
+// only add synchronization for a workload a single goroutine already
+// handles in O(log n) depth.
+//
+// workers<=0 defaults to runtime.GOMAXPROCS(0). pool may be nil, in which
+// case each block allocates its own scratch.
+func BatchInvMontBlockParallel(xs []uint32, blockSize int, workers int, pool *ScratchPool) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if blockSize <= 0 || blockSize >= n {
+		blockSize = n
+	}
+	if n < blockPipelineMinN || blockSize == n {
+		BatchInvMontTree(xs, pool.get(n))
+		return
+	}
+
+	numBlocks := (n + blockSize - 1) / blockSize
+	if workers > numBlocks {
+		workers = numBlocks
+	}
+
+	upsweepCh := make(chan *blockJob, numBlocks)
+	invertCh := make(chan *blockJob, numBlocks)
+	downsweepCh := make(chan *blockJob, numBlocks)
+
+	var upWG, downWG sync.WaitGroup
+
+	upWG.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer upWG.Done()
+			for job := range upsweepCh {
+				blockUpsweep(job)
+				invertCh <- job
+			}
+		}()
+	}
+
+	go func() {
+		for job := range invertCh {
+			job.total = InvMont(reduce(job.total))
+			downsweepCh <- job
+		}
+		close(downsweepCh)
+	}()
+
+	downWG.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer downWG.Done()
+			for job := range downsweepCh {
+				blockDownsweep(job)
+				pool.put(job.scratch)
+			}
+		}()
+	}
+
+	for start := 0; start < n; start += blockSize {
+		end := start + blockSize
+		if end > n {
+			end = n
+		}
+		block := xs[start:end]
+		upsweepCh <- &blockJob{xs: block, scratch: pool.get(len(block))}
+	}
+	close(upsweepCh)
+
+	upWG.Wait()
+	close(invertCh)
+	downWG.Wait()
+}
+
+// blockUpsweep fills job.scratch with job.xs's forward prefix products
+// (zeros treated as 1_M, matching BatchInvMontTree's convention) and
+// records the block's total product in job.total.
+func blockUpsweep(job *blockJob) {
+	xs := job.xs
+	prods := job.scratch[:len(xs)]
+	oneM := ToMont(1)
+
+	if xs[0] == 0 {
+		prods[0] = oneM
+	} else {
+		prods[0] = xs[0]
+	}
+	for i := 1; i < len(xs); i++ {
+		if xs[i] == 0 {
+			prods[i] = prods[i-1]
+		} else {
+			prods[i] = mulMontLazy(prods[i-1], xs[i])
+		}
+	}
+	job.total = prods[len(xs)-1]
+}
+
+// blockDownsweep turns job.total's inverse (already computed by the
+// inversion stage) back into per-element inverses, written in place into
+// job.xs.
+func blockDownsweep(job *blockJob) {
+	xs := job.xs
+	prods := job.scratch[:len(xs)]
+	inv := job.total
+
+	for i := len(xs) - 1; i > 0; i-- {
+		if xs[i] == 0 {
+			continue
+		}
+		oldXi := xs[i]
+		xs[i] = MulMont(inv, prods[i-1])
+		inv = mulMontLazy(inv, oldXi)
+	}
+	if xs[0] != 0 {
+		xs[0] = reduce(inv)
+	}
+}
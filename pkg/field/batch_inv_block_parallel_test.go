@@ -0,0 +1,104 @@
+package field
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestBatchInvMontBlockParallelMatchesTree(t *testing.T) {
+	for _, n := range []int{0, 1, 35, 256, 257, 1000, 4096, 10007} {
+		for _, blockSize := range []int{0, 1, 35, 256, n} {
+			for _, workers := range []int{0, 1, 2, 8} {
+				xs1 := make([]uint32, n)
+				xs2 := make([]uint32, n)
+				for i := 0; i < n; i++ {
+					v := ToMont(uint32(i + 1))
+					if n > 0 && i%97 == 5 {
+						v = 0
+					}
+					xs1[i] = v
+					xs2[i] = v
+				}
+				BatchInvMontTree(xs1, make([]uint32, 3*n+8))
+				BatchInvMontBlockParallel(xs2, blockSize, workers, nil)
+				for i := 0; i < n; i++ {
+					if xs1[i] != xs2[i] {
+						t.Fatalf("n=%d blockSize=%d workers=%d i=%d tree=%d block=%d",
+							n, blockSize, workers, i, xs1[i], xs2[i])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestBatchInvMontBlockParallelWithScratchPool(t *testing.T) {
+	n := 4096
+	xsRef := make([]uint32, n)
+	xs := make([]uint32, n)
+	for i := 0; i < n; i++ {
+		v := ToMont(uint32(i + 1))
+		xsRef[i] = v
+		xs[i] = v
+	}
+
+	BatchInvMontTree(xsRef, make([]uint32, 3*n))
+
+	pool := NewScratchPool(128)
+	for iter := 0; iter < 3; iter++ {
+		for i := 0; i < n; i++ {
+			xs[i] = ToMont(uint32(i + 1))
+		}
+		BatchInvMontBlockParallel(xs, 128, 4, pool)
+		for i := 0; i < n; i++ {
+			if xs[i] != xsRef[i] {
+				t.Fatalf("iter=%d i=%d: got %d, want %d", iter, i, xs[i], xsRef[i])
+			}
+		}
+	}
+}
+
+func benchBatchInvMontBlockParallel(b *testing.B, n int) {
+	xs := make([]uint32, n)
+	for i := range xs {
+		xs[i] = ToMont(uint32(i + 1))
+	}
+	pool := NewScratchPool(256)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range xs {
+			xs[j] = ToMont(uint32(j + 1))
+		}
+		BatchInvMontBlockParallel(xs, 256, 0, pool)
+	}
+}
+
+func benchBatchInvMontDispatch(b *testing.B, n int) {
+	xs := make([]uint32, n)
+	scratch := make([]uint32, 3*n)
+	for i := range xs {
+		xs[i] = ToMont(uint32(i + 1))
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := range xs {
+			xs[j] = ToMont(uint32(j + 1))
+		}
+		BatchInvMontDispatch(xs, scratch)
+	}
+}
+
+// BenchmarkBatchInvCrossover compares BatchInvMontBlockParallel against
+// BatchInvMontDispatch (which itself routes n<=8 to the linear BatchInvMont
+// sweep and larger n to BatchInvMontParallel or BatchInvMontTreeNoZeroILP4,
+// depending on montVectorWidth) at a spread of sizes, to locate the n where
+// worker/pipeline overhead stops dominating and parallelism wins.
+func BenchmarkBatchInvCrossover(b *testing.B) {
+	for _, n := range []int{1, 4, 35, 256, 4096} {
+		name := strconv.Itoa(n)
+		b.Run("Dispatch/"+name, func(b *testing.B) { benchBatchInvMontDispatch(b, n) })
+		b.Run("BlockParallel/"+name, func(b *testing.B) { benchBatchInvMontBlockParallel(b, n) })
+	}
+}
@@ -0,0 +1,65 @@
+package field
+
+// BatchInvImpl is the signature shared by every BatchInv* backend in this
+// package: invert len(xs) Montgomery-form field elements in place, using
+// scratch (capacity >= 3*len(xs)) for intermediate products.
+type BatchInvImpl func(xs, scratch []uint32)
+
+// batchInvSmall, batchInvMedium and batchInvLarge are the backends
+// BatchInvMontDispatch picks between once zeros have been ruled out:
+//
+//   - batchInvSmall (BatchInvMont's linear prefix-product sweep) is
+//     cheapest for the handful of elements where the tree's layer
+//     bookkeeping in BatchInvMontTreeNoZeroILP4 doesn't pay for itself.
+//   - batchInvMedium (BatchInvMontParallel's branchless pair processing)
+//     covers the mid-size, scalar-only case: its 2-at-a-time ILP beats the
+//     tree's O(log n) depth advantage when there's no vector width for the
+//     tree's up-sweep/down-sweep to batch into.
+//   - batchInvLarge (BatchInvMontTreeNoZeroILP4) wins everywhere else,
+//     including every case where montVectorWidth > 1 lets its up-sweep and
+//     down-sweep batch through mulMontLazy8/mulMontLazy16.
+var (
+	batchInvSmall  BatchInvImpl = BatchInvMont
+	batchInvMedium BatchInvImpl = BatchInvMontParallel
+	batchInvLarge  BatchInvImpl = BatchInvMontTreeNoZeroILP4
+)
+
+// batchInvSmallCutover and batchInvMediumCutover are the n thresholds
+// BatchInvMontDispatch uses to choose among batchInvSmall/batchInvMedium/
+// batchInvLarge above.
+const (
+	batchInvSmallCutover  = 8
+	batchInvMediumCutover = 64
+)
+
+// BatchInvMontDispatch is the entry point Poseidon (and any future caller)
+// should use instead of picking one of the BatchInv*/BatchInvMontTree*
+// variants in this package directly: it detects zeros and picks the
+// fastest backend for len(xs) and the running CPU's vector width
+// (montVectorWidth, populated at init from golang.org/x/sys/cpu in cpu.go,
+// always 1 under -tags=purego per cpu_purego.go), so new backends land
+// here without every call site needing to change.
+//
+// scratch must have capacity >= 3*len(xs).
+func BatchInvMontDispatch(xs []uint32, scratch []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	for _, x := range xs {
+		if x == 0 {
+			BatchInvMontTree(xs, scratch)
+			return
+		}
+	}
+
+	switch {
+	case n <= batchInvSmallCutover:
+		batchInvSmall(xs, scratch)
+	case montVectorWidth == 1 && n <= batchInvMediumCutover:
+		batchInvMedium(xs, scratch)
+	default:
+		batchInvLarge(xs, scratch)
+	}
+}
@@ -0,0 +1,44 @@
+package field
+
+// BatchInvMontLinear computes batch modular inverse with a single linear
+// forward/backward pass over xs (Montgomery's trick), with no ILP pairing
+// or branchless masking. It exists as the plain, easy-to-audit reference
+// that BatchInvMontParallel and BatchInvMontTree are checked against.
+func BatchInvMontLinear(xs []uint32, scratch []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	prods := scratch[:n]
+
+	// Forward pass: prods[i] = x[0]*x[1]*...*x[i] in Montgomery form,
+	// skipping zero elements (treated as if they were 1) so the chain
+	// stays invertible.
+	prods[0] = xs[0]
+	if prods[0] == 0 {
+		prods[0] = ToMont(1)
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] == 0 {
+			prods[i] = prods[i-1]
+		} else {
+			prods[i] = MulMont(prods[i-1], xs[i])
+		}
+	}
+
+	inv := InvMont(prods[n-1])
+
+	// Backward pass: peel off one element at a time.
+	for i := n - 1; i > 0; i-- {
+		if xs[i] == 0 {
+			continue
+		}
+		oldXi := xs[i]
+		xs[i] = MulMont(inv, prods[i-1])
+		inv = MulMont(inv, oldXi)
+	}
+	if xs[0] != 0 {
+		xs[0] = inv
+	}
+}
@@ -105,7 +105,7 @@ func BenchmarkBatchInvMontLinear(b *testing.B) {
 	}
 }
 
-func BenchmarkBatchInvMontOriginal2(b *testing.B) {
+func BenchmarkBatchInvMontOriginalForLinear(b *testing.B) {
 	xs := make([]uint32, PosT)
 	scratch := make([]uint32, PosT)
 	for i := range xs {
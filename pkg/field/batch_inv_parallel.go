@@ -61,8 +61,11 @@ func BatchInvMontParallel(xs []uint32, scratch []uint32) {
 		prods[i] = mulMontLazy(prods[i-1], safe)
 	}
 
-	// Invert final product
-	inv := InvMont(reduce(prods[n-1]))
+	// Invert final product. Uses the constant-time addition chain: this
+	// function is called on secret coefficients during signing, and
+	// InvMont's `if aM == 0` early return would otherwise leak whether the
+	// accumulated product happened to be zero.
+	inv := InvMontCT(reduce(prods[n-1]))
 
 	// Backward pass: compute individual inverses
 	// Process pairs for ILP
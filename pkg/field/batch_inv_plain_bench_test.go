@@ -591,6 +591,28 @@ func TestMulPlainLazy2Matches(t *testing.T) {
 	}
 }
 
+func TestMulPlainLazy8Matches(t *testing.T) {
+	x := uint32(1)
+	y := uint32(2)
+	for i := 0; i < 100000; i++ {
+		var a, b [8]uint32
+		for lane := 0; lane < 8; lane++ {
+			x = x*1664525 + 1013904223
+			y = y*22695477 + 1
+			a[lane] = x % (2 * Q)
+			b[lane] = y % (2 * Q)
+		}
+
+		got := mulPlainLazy8(&a, &b)
+		for lane := 0; lane < 8; lane++ {
+			want := uint32((uint64(a[lane]) * uint64(b[lane])) % uint64(Q))
+			if got[lane] >= 2*Q || reduce(got[lane]) != want {
+				t.Fatalf("lane%d a=%d b=%d got=%d reduced=%d want=%d", lane, a[lane], b[lane], got[lane], reduce(got[lane]), want)
+			}
+		}
+	}
+}
+
 func TestInvPlainLazyMatches(t *testing.T) {
 	for v := uint32(1); v < 200000; v++ {
 		a := v % Q
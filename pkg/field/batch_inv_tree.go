@@ -428,6 +428,14 @@ func batchInvMontTreeNoZeroILP4_35(xs []uint32, scratch []uint32) {
 
 // BatchInvMontTreeNoZeroILP4 is like BatchInvMontTreeNoZero but with 4-pair unrolling
 // in up-sweep and down-sweep for better instruction-level parallelism.
+//
+// When montVectorWidth (cpu.go) reports 8 or 16 vector lanes, the up-sweep
+// and down-sweep loops below batch their independent multiplies through
+// mulMontLazy8/mulMontLazy16 over contiguous runs of scratch instead of the
+// scalar 4-pair unrolling, falling back to the unrolled scalar loop (and
+// then the single-pair loop) for whatever doesn't divide evenly into a
+// vector width. mulMontLazy8/mulMontLazy16 are themselves scalar loops;
+// mont_lazy_generic.go documents that no vector kernel exists behind them.
 func BatchInvMontTreeNoZeroILP4(xs []uint32, scratch []uint32) {
 	n := len(xs)
 	if n == 0 {
@@ -437,9 +445,42 @@ func BatchInvMontTreeNoZeroILP4(xs []uint32, scratch []uint32) {
 		xs[0] = InvMont(reduce(xs[0]))
 		return
 	}
-	if n == PosT {
+	// Fixed-size specializations: precomputed layer offsets (generated, or
+	// hand-unrolled for PosT) let the compiler keep the whole tree's
+	// intermediates in registers instead of indexing through the
+	// layerOff/layerCnt arrays built below. See pkg/field/internal/gen and
+	// the batch_inv_tree_n*_mont.go files it produced.
+	switch n {
+	case PosT:
 		batchInvMontTreeNoZeroILP4_35(xs, scratch)
 		return
+	case 8:
+		batchInvMontTree_8(xs, scratch)
+		return
+	case 12:
+		batchInvMontTree_12(xs, scratch)
+		return
+	case 16:
+		batchInvMontTree_16(xs, scratch)
+		return
+	case 24:
+		batchInvMontTree_24(xs, scratch)
+		return
+	case 32:
+		batchInvMontTree_32(xs, scratch)
+		return
+	case 48:
+		batchInvMontTree_48(xs, scratch)
+		return
+	case 64:
+		batchInvMontTree_64(xs, scratch)
+		return
+	case 128:
+		batchInvMontTree_128(xs, scratch)
+		return
+	case 256:
+		batchInvMontTree_256(xs, scratch)
+		return
 	}
 
 	work := scratch[:n]
@@ -466,7 +507,7 @@ func BatchInvMontTreeNoZeroILP4(xs []uint32, scratch []uint32) {
 		currentCount = nextCount
 	}
 
-	// ============ UP-SWEEP with 4-pair unrolling ============
+	// ============ UP-SWEEP: vector-batched, 4-pair unrolling for the rest ============
 	for l := 0; l < maxLayers; l++ {
 		srcOff := layerOff[l]
 		srcCnt := layerCnt[l]
@@ -474,6 +515,28 @@ func BatchInvMontTreeNoZeroILP4(xs []uint32, scratch []uint32) {
 		pairs := srcCnt / 2
 
 		p := 0
+		if montVectorWidth >= 16 {
+			for ; p+15 < pairs; p += 16 {
+				var a, b [16]uint32
+				for k := 0; k < 16; k++ {
+					a[k] = scratch[srcOff+(p+k)*2]
+					b[k] = scratch[srcOff+(p+k)*2+1]
+				}
+				r := mulMontLazy16(&a, &b)
+				copy(scratch[dstOff+p:dstOff+p+16], r[:])
+			}
+		}
+		if montVectorWidth >= 8 {
+			for ; p+7 < pairs; p += 8 {
+				var a, b [8]uint32
+				for k := 0; k < 8; k++ {
+					a[k] = scratch[srcOff+(p+k)*2]
+					b[k] = scratch[srcOff+(p+k)*2+1]
+				}
+				r := mulMontLazy8(&a, &b)
+				copy(scratch[dstOff+p:dstOff+p+8], r[:])
+			}
+		}
 		for ; p+3 < pairs; p += 4 {
 			s0 := scratch[srcOff+p*2]
 			s1 := scratch[srcOff+p*2+1]
@@ -500,7 +563,9 @@ func BatchInvMontTreeNoZeroILP4(xs []uint32, scratch []uint32) {
 	rootOff := layerOff[maxLayers]
 	scratch[rootOff] = InvMont(reduce(scratch[rootOff]))
 
-	// ============ DOWN-SWEEP with 4-pair unrolling ============
+	// ============ DOWN-SWEEP: vector-batched, 4-pair unrolling for the rest ============
+	// Each pair cross-multiplies into 2 lanes, so a vector of width W covers
+	// W/2 pairs at once.
 	for l := maxLayers; l > 0; l-- {
 		parentOff := layerOff[l]
 		childOff := layerOff[l-1]
@@ -508,6 +573,44 @@ func BatchInvMontTreeNoZeroILP4(xs []uint32, scratch []uint32) {
 		pairs := childCnt / 2
 
 		p := 0
+		if montVectorWidth >= 16 {
+			for ; p+7 < pairs; p += 8 {
+				var a, b [16]uint32
+				for k := 0; k < 8; k++ {
+					parentInv := scratch[parentOff+p+k]
+					leftVal := scratch[childOff+(p+k)*2]
+					rightVal := scratch[childOff+(p+k)*2+1]
+					a[2*k] = parentInv
+					b[2*k] = rightVal
+					a[2*k+1] = parentInv
+					b[2*k+1] = leftVal
+				}
+				r := mulMontLazy16(&a, &b)
+				for k := 0; k < 8; k++ {
+					scratch[childOff+(p+k)*2] = r[2*k]
+					scratch[childOff+(p+k)*2+1] = r[2*k+1]
+				}
+			}
+		}
+		if montVectorWidth >= 8 {
+			for ; p+3 < pairs; p += 4 {
+				var a, b [8]uint32
+				for k := 0; k < 4; k++ {
+					parentInv := scratch[parentOff+p+k]
+					leftVal := scratch[childOff+(p+k)*2]
+					rightVal := scratch[childOff+(p+k)*2+1]
+					a[2*k] = parentInv
+					b[2*k] = rightVal
+					a[2*k+1] = parentInv
+					b[2*k+1] = leftVal
+				}
+				r := mulMontLazy8(&a, &b)
+				for k := 0; k < 4; k++ {
+					scratch[childOff+(p+k)*2] = r[2*k]
+					scratch[childOff+(p+k)*2+1] = r[2*k+1]
+				}
+			}
+		}
 		for ; p+3 < pairs; p += 4 {
 			p1 := scratch[parentOff+p]
 			p2 := scratch[parentOff+p+1]
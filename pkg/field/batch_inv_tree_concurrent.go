@@ -0,0 +1,150 @@
+package field
+
+import (
+	"runtime"
+	"sync"
+)
+
+// batchInvConcurrentMinN is the smallest len(xs) BatchInvMontTreeConcurrent
+// will bother parallelizing: below this, goroutine spin-up costs more than
+// the single-threaded tree's O(log n) depth saves.
+const batchInvConcurrentMinN = 4096
+
+// batchInvConcurrentMinSegment is the minimum number of elements a worker
+// must own for splitting to pay off; BatchInvMontTreeConcurrent folds back
+// to the single-threaded path rather than handing a worker less than this.
+const batchInvConcurrentMinSegment = 4096
+
+// cacheLineElems is the number of uint32 slots in a 64-byte cache line.
+// Segment boundaries are rounded up to this so two workers never write
+// into the same line of xs or scratch (false sharing).
+const cacheLineElems = 64 / 4
+
+// BatchInvMontTreeConcurrent is BatchInvMontTree's goroutine-parallel
+// counterpart for large arrays — the verifier-side case where thousands of
+// Poseidon evaluations run per batch and a single core's O(log n) tree
+// depth is still bounded by the total work.
+//
+// It splits xs into cache-line-aligned, disjoint segments (one per worker)
+// and applies Montgomery's batch-inversion trick hierarchically instead of
+// literally sharing one binary tree across goroutines:
+//
+//  1. (parallel) each worker computes its segment's forward prefix
+//     products into its own region of scratch, landing on that segment's
+//     total product.
+//  2. (single goroutine) the per-segment products — only `workers` of them,
+//     not `n` — are themselves batch-inverted via BatchInvMont. This is the
+//     one real field inversion the whole call pays, no matter how many
+//     segments there are.
+//  3. (parallel) each worker runs the standard backward sweep over its
+//     segment, seeded with its segment's inverse from step 2 instead of a
+//     fresh InvMont call, writing final inverses into xs.
+//
+// This is the same total multiply count as running BatchInvMont once per
+// segment, minus the (workers-1) extra inversions that would otherwise
+// cost — segment products are recombined with one batch inversion instead.
+//
+// xs may contain zeros (treated as 1 internally and left as 0 in the
+// output, matching BatchInvMontTree). scratch must have capacity >=
+// 3*len(xs) and must not alias xs — only the first len(xs) slots are used
+// by the parallel path itself, but the small-input fallback below hands
+// scratch straight to BatchInvMontTree, which needs the full 3n. workers
+// is clamped to runtime.GOMAXPROCS(0); BatchInvMontTreeConcurrent falls
+// back to the single-threaded BatchInvMontTree when n, workers, or
+// n/workers falls below the thresholds above.
+func BatchInvMontTreeConcurrent(xs []uint32, scratch []uint32, workers int) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	if maxProcs := runtime.GOMAXPROCS(0); workers > maxProcs {
+		workers = maxProcs
+	}
+	if workers < 1 {
+		workers = 1
+	}
+
+	if n < batchInvConcurrentMinN || workers <= 1 || n/workers < batchInvConcurrentMinSegment {
+		BatchInvMontTree(xs, scratch)
+		return
+	}
+
+	segSize := roundUpCacheLine((n + workers - 1) / workers)
+	numSegs := (n + segSize - 1) / segSize
+
+	oneM := ToMont(1)
+	segProd := make([]uint32, numSegs)
+
+	segBounds := func(s int) (start, end int) {
+		start = s * segSize
+		end = start + segSize
+		if end > n {
+			end = n
+		}
+		return
+	}
+
+	// ============ PHASE 1: parallel forward prefix products ============
+	var wg sync.WaitGroup
+	for s := 0; s < numSegs; s++ {
+		start, end := segBounds(s)
+		wg.Add(1)
+		go func(s, start, end int) {
+			defer wg.Done()
+			seg := xs[start:end]
+			prods := scratch[start:end]
+
+			prods[0] = seg[0]
+			if prods[0] == 0 {
+				prods[0] = oneM
+			}
+			for i := 1; i < len(seg); i++ {
+				if seg[i] == 0 {
+					prods[i] = prods[i-1]
+				} else {
+					prods[i] = mulMontLazy(prods[i-1], seg[i])
+				}
+			}
+			segProd[s] = reduce(prods[len(seg)-1])
+		}(s, start, end)
+	}
+	wg.Wait()
+
+	// ============ PHASE 2: single-threaded combine + one inversion ============
+	segScratch := make([]uint32, numSegs)
+	BatchInvMont(segProd, segScratch)
+
+	// ============ PHASE 3: parallel backward sweep, seeded per segment ============
+	for s := 0; s < numSegs; s++ {
+		start, end := segBounds(s)
+		wg.Add(1)
+		go func(s, start, end int) {
+			defer wg.Done()
+			seg := xs[start:end]
+			prods := scratch[start:end]
+			inv := segProd[s]
+
+			for i := len(seg) - 1; i > 0; i-- {
+				if seg[i] == 0 {
+					continue
+				}
+				oldXi := seg[i]
+				seg[i] = MulMont(inv, prods[i-1])
+				inv = mulMontLazy(inv, oldXi)
+			}
+			if seg[0] != 0 {
+				seg[0] = reduce(inv)
+			}
+		}(s, start, end)
+	}
+	wg.Wait()
+}
+
+// roundUpCacheLine rounds n up to the nearest multiple of cacheLineElems.
+func roundUpCacheLine(n int) int {
+	if r := n % cacheLineElems; r != 0 {
+		n += cacheLineElems - r
+	}
+	return n
+}
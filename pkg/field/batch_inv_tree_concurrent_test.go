@@ -0,0 +1,29 @@
+package field
+
+import "testing"
+
+func TestBatchInvMontTreeConcurrentMatchesTree(t *testing.T) {
+	for _, n := range []int{0, 1, 4095, 4096, 5000, 8192, 10007, 20000} {
+		for _, workers := range []int{0, 1, 2, 3, 8} {
+			xs1 := make([]uint32, n)
+			xs2 := make([]uint32, n)
+			for i := 0; i < n; i++ {
+				v := ToMont(uint32(i + 1))
+				if n > 0 && i%97 == 5 {
+					v = 0
+				}
+				xs1[i] = v
+				xs2[i] = v
+			}
+			scratch1 := make([]uint32, 3*n+8)
+			scratch2 := make([]uint32, 3*n+8)
+			BatchInvMontTree(xs1, scratch1)
+			BatchInvMontTreeConcurrent(xs2, scratch2, workers)
+			for i := 0; i < n; i++ {
+				if xs1[i] != xs2[i] {
+					t.Fatalf("n=%d workers=%d i=%d tree=%d concurrent=%d", n, workers, i, xs1[i], xs2[i])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package field
+
+// BatchInvTreeCondPlainCT and BatchInvMontTreeCondCT are BatchInvTreeCondPlain
+// and BatchInvMontTreeCond without the `xs[i] == 0` branches in their
+// zero-conditioning pre/post passes — the same leak CtBatchInv/CtBatchInvMont
+// close for the linear tree, applied to the O(log n) tree instead. Use these
+// whenever xs holds secret data (e.g. polynomial coefficients touched during
+// signing): the branching variants are faster but let an attacker who can
+// measure wall-clock time learn which coordinates were zero. Callers that
+// only ever see public data (e.g. Poseidon's sponge state, which every
+// verifier recomputes from the public transcript) should keep using the
+// branching fast path instead — these CT variants deliberately skip the
+// pkg/field/internal/gen-generated per-size specializations (those still
+// call invPlainLazy/InvMont, whose zero-branch is only safe here because
+// the masked input is provably non-zero, a property this file doesn't want
+// to depend on) and run the generic runtime-loop tree instead, so they are
+// slower than BatchInvTreeCondPlain/BatchInvMontTreeCond at sizes that do
+// have a specialization.
+
+// BatchInvTreeCondPlainCT is BatchInvTreeCondPlain's constant-time
+// counterpart: zeros are masked in rather than branched around, and the
+// root inversion runs invPlainLazyCT's branch-free chain. scratch must have
+// capacity >= 3*n, same as BatchInvTree.
+func BatchInvTreeCondPlainCT(xs []uint32, scratch []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	if n == 1 {
+		xs[0] = InvCT(xs[0])
+		return
+	}
+
+	work := scratch[:n]
+	for i := 0; i < n; i++ {
+		work[i] = selectU32(eqMask32(xs[i], 0), 1, xs[i])
+	}
+
+	batchInvTreeCT(n, scratch)
+
+	for i := 0; i < n; i++ {
+		xs[i] = selectU32(eqMask32(xs[i], 0), 0, work[i])
+	}
+}
+
+// batchInvTreeCT runs BatchInvTree's tree algorithm over scratch[:n]
+// in place — the caller has already written a zero-free, masked copy of
+// its input there — using scratch[n:] for the upper layers, and leaves the
+// result in scratch[:n]. No step below branches on a scratch value, so
+// this is safe to call directly on secret data once the zero mask has
+// been folded in. scratch must have capacity >= 3*n.
+func batchInvTreeCT(n int, scratch []uint32) {
+	maxLayers := 0
+	for temp := n; temp > 1; temp = (temp + 1) / 2 {
+		maxLayers++
+	}
+
+	var layerOff [16]int
+	var layerCnt [16]int
+
+	layerOff[0] = 0
+	layerCnt[0] = n
+
+	offset := n
+	currentCount := n
+	for l := 1; l <= maxLayers; l++ {
+		nextCount := (currentCount + 1) / 2
+		layerOff[l] = offset
+		layerCnt[l] = nextCount
+		offset += nextCount
+		currentCount = nextCount
+	}
+
+	// ============ UP-SWEEP ============
+	for l := 0; l < maxLayers; l++ {
+		srcOff := layerOff[l]
+		srcCnt := layerCnt[l]
+		dstOff := layerOff[l+1]
+
+		pairs := srcCnt / 2
+		for p := 0; p < pairs; p++ {
+			scratch[dstOff+p] = mulPlainLazy(scratch[srcOff+p*2], scratch[srcOff+p*2+1])
+		}
+		if srcCnt%2 == 1 {
+			scratch[dstOff+pairs] = scratch[srcOff+srcCnt-1]
+		}
+	}
+
+	// ============ INVERT ROOT ============
+	rootOff := layerOff[maxLayers]
+	scratch[rootOff] = invPlainLazyCT(reduce(scratch[rootOff]))
+
+	// ============ DOWN-SWEEP ============
+	for l := maxLayers; l > 0; l-- {
+		parentOff := layerOff[l]
+		childOff := layerOff[l-1]
+		childCnt := layerCnt[l-1]
+		pairs := childCnt / 2
+
+		for p := 0; p < pairs; p++ {
+			parentInv := scratch[parentOff+p]
+			leftVal := scratch[childOff+p*2]
+			rightVal := scratch[childOff+p*2+1]
+
+			scratch[childOff+p*2] = mulPlainLazy(parentInv, rightVal)
+			scratch[childOff+p*2+1] = mulPlainLazy(parentInv, leftVal)
+		}
+		if childCnt%2 == 1 {
+			scratch[childOff+childCnt-1] = scratch[parentOff+pairs]
+		}
+	}
+
+	// ============ WRITE BACK ============
+	for i := 0; i < n; i++ {
+		scratch[i] = reduce(scratch[i])
+	}
+}
+
+// BatchInvMontTreeCondCT is BatchInvMontTreeCond's constant-time
+// counterpart: zeros are masked in rather than branched around, and the
+// root inversion runs InvMontCT's branch-free chain. scratch must have
+// capacity >= 3*n, same as BatchInvMontTree.
+func BatchInvMontTreeCondCT(xs []uint32, scratch []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	if n == 1 {
+		xs[0] = InvMontCT(reduce(xs[0]))
+		return
+	}
+
+	oneM := ToMont(1)
+	work := scratch[:n]
+	for i := 0; i < n; i++ {
+		work[i] = selectU32(eqMask32(xs[i], 0), oneM, xs[i])
+	}
+
+	batchInvMontTreeCT(n, scratch)
+
+	for i := 0; i < n; i++ {
+		xs[i] = selectU32(eqMask32(xs[i], 0), 0, work[i])
+	}
+}
+
+// batchInvMontTreeCT is batchInvTreeCT's Montgomery-domain counterpart:
+// runs over scratch[:n] (already zero-free) in place, using scratch[n:]
+// for the upper layers. scratch must have capacity >= 3*n.
+func batchInvMontTreeCT(n int, scratch []uint32) {
+	maxLayers := 0
+	for temp := n; temp > 1; temp = (temp + 1) / 2 {
+		maxLayers++
+	}
+
+	var layerOff [16]int
+	var layerCnt [16]int
+
+	layerOff[0] = 0
+	layerCnt[0] = n
+
+	offset := n
+	currentCount := n
+	for l := 1; l <= maxLayers; l++ {
+		nextCount := (currentCount + 1) / 2
+		layerOff[l] = offset
+		layerCnt[l] = nextCount
+		offset += nextCount
+		currentCount = nextCount
+	}
+
+	// ============ UP-SWEEP ============
+	for l := 0; l < maxLayers; l++ {
+		srcOff := layerOff[l]
+		srcCnt := layerCnt[l]
+		dstOff := layerOff[l+1]
+
+		pairs := srcCnt / 2
+		for p := 0; p < pairs; p++ {
+			scratch[dstOff+p] = mulMontLazy(scratch[srcOff+p*2], scratch[srcOff+p*2+1])
+		}
+		if srcCnt%2 == 1 {
+			scratch[dstOff+pairs] = scratch[srcOff+srcCnt-1]
+		}
+	}
+
+	// ============ INVERT ROOT ============
+	rootOff := layerOff[maxLayers]
+	scratch[rootOff] = InvMontCT(reduce(scratch[rootOff]))
+
+	// ============ DOWN-SWEEP ============
+	for l := maxLayers; l > 0; l-- {
+		parentOff := layerOff[l]
+		childOff := layerOff[l-1]
+		childCnt := layerCnt[l-1]
+		pairs := childCnt / 2
+
+		for p := 0; p < pairs; p++ {
+			parentInv := scratch[parentOff+p]
+			leftVal := scratch[childOff+p*2]
+			rightVal := scratch[childOff+p*2+1]
+
+			scratch[childOff+p*2] = mulMontLazy(parentInv, rightVal)
+			scratch[childOff+p*2+1] = mulMontLazy(parentInv, leftVal)
+		}
+		if childCnt%2 == 1 {
+			scratch[childOff+childCnt-1] = scratch[parentOff+pairs]
+		}
+	}
+
+	// ============ WRITE BACK ============
+	for i := 0; i < n; i++ {
+		scratch[i] = reduce(scratch[i])
+	}
+}
@@ -0,0 +1,118 @@
+package field
+
+// BatchInvMontTreeFanout is a generic k-ary batch-inversion tree: it groups
+// xs into blocks of at most `fanout` elements, inverts the product of each
+// block's tree recursively, then has each block scatter the shared
+// inversion back out locally. This generalizes BatchInvMontTree (which is
+// fixed at a binary fanout and relies on a 4-pair-unrolled fast path for
+// n == PosT) to arbitrary group sizes, for callers sizing their own
+// Merkle-style fan-outs instead of PosT == 35.
+//
+// All inputs and outputs are in Montgomery form. Elements that are 0 remain
+// 0. scratch is used to stage group products between levels: each level
+// consumes ceil(n_i/fanout) slots and hands the rest down to the next
+// level's recursive call, and because an odd leftover group at a level
+// passes straight through as its own group of 1 (not a true binary merge),
+// the levels' slot counts can sum to slightly more than len(xs). scratch
+// must have length >= 2*len(xs) to leave headroom for that; it is not a
+// hard zero-allocation guarantee (unlike the hand-tuned ILP4 path, which
+// this function does not replace).
+func BatchInvMontTreeFanout(xs []uint32, scratch []uint32, fanout int) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	if fanout < 2 {
+		fanout = 2
+	}
+	if n <= fanout {
+		invertGroupMont(xs, invertGroupProductMont(xs))
+		return
+	}
+
+	groups := (n + fanout - 1) / fanout
+	groupProd := scratch[:groups]
+	for g := 0; g < groups; g++ {
+		lo, hi := g*fanout, g*fanout+fanout
+		if hi > n {
+			hi = n
+		}
+		groupProd[g] = groupProductMont(xs[lo:hi])
+	}
+
+	// Recurse on the (much smaller) array of group products; this is the
+	// tree's internal nodes, bottoming out at a single root inversion.
+	BatchInvMontTreeFanout(groupProd, scratch[groups:], fanout)
+
+	for g := 0; g < groups; g++ {
+		lo, hi := g*fanout, g*fanout+fanout
+		if hi > n {
+			hi = n
+		}
+		invertGroupMont(xs[lo:hi], groupProd[g])
+	}
+}
+
+// groupProductMont returns the Montgomery-form product of xs, treating any
+// zero element as Montgomery-1 (matching BatchInvMont's zero handling).
+func groupProductMont(xs []uint32) uint32 {
+	prod := xs[0]
+	if prod == 0 {
+		prod = ToMont(1)
+	}
+	for i := 1; i < len(xs); i++ {
+		x := xs[i]
+		if x == 0 {
+			x = ToMont(1)
+		}
+		prod = reduce(mulMontLazy(prod, x))
+	}
+	return prod
+}
+
+// invertGroupProductMont inverts a single group's product directly; used
+// for the recursion base case (a group small enough it is its own tree).
+func invertGroupProductMont(xs []uint32) uint32 {
+	return InvMont(groupProductMont(xs))
+}
+
+// invertGroupMont distributes a known product inverse across a block,
+// using the classic Montgomery batch trick's backward pass seeded with
+// invProd instead of recomputing it locally.
+func invertGroupMont(xs []uint32, invProd uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	var prefix [64]uint32 // fanout is expected to be small (<=64); falls back below otherwise
+	var prods []uint32
+	if n <= len(prefix) {
+		prods = prefix[:n]
+	} else {
+		prods = make([]uint32, n)
+	}
+	prods[0] = xs[0]
+	if prods[0] == 0 {
+		prods[0] = ToMont(1)
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] == 0 {
+			prods[i] = prods[i-1]
+		} else {
+			prods[i] = mulMontLazy(prods[i-1], xs[i])
+		}
+	}
+
+	inv := invProd
+	for i := n - 1; i > 0; i-- {
+		if xs[i] == 0 {
+			continue
+		}
+		oldXi := xs[i]
+		xs[i] = MulMont(inv, prods[i-1])
+		inv = mulMontLazy(inv, oldXi)
+	}
+	if xs[0] != 0 {
+		xs[0] = reduce(inv)
+	}
+}
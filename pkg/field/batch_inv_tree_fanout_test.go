@@ -0,0 +1,59 @@
+package field
+
+import "testing"
+
+func TestBatchInvMontTreeFanoutMatchesBatchInvMont(t *testing.T) {
+	sizes := []int{1, 2, 3, 4, 5, 7, 8, 15, 16, 31, 32, 35, 63, 64, 100}
+	fanouts := []int{2, 4, 8}
+
+	for _, fanout := range fanouts {
+		for _, n := range sizes {
+			xsWant := make([]uint32, n)
+			xsGot := make([]uint32, n)
+			for i := 0; i < n; i++ {
+				v := ToMont(uint32(i + 1))
+				xsWant[i] = v
+				xsGot[i] = v
+			}
+
+			scratch := make([]uint32, n)
+			BatchInvMont(xsWant, scratch)
+
+			scratchFanout := make([]uint32, 2*n)
+			BatchInvMontTreeFanout(xsGot, scratchFanout, fanout)
+
+			for i := 0; i < n; i++ {
+				if xsWant[i] != xsGot[i] {
+					t.Fatalf("fanout=%d n=%d index %d: BatchInvMont=%d BatchInvMontTreeFanout=%d",
+						fanout, n, i, xsWant[i], xsGot[i])
+				}
+			}
+		}
+	}
+}
+
+func TestBatchInvMontTreeFanoutWithZeros(t *testing.T) {
+	n := 35
+	xsWant := make([]uint32, n)
+	xsGot := make([]uint32, n)
+	for i := 0; i < n; i++ {
+		v := uint32(0)
+		if i%5 != 0 {
+			v = ToMont(uint32(i + 1))
+		}
+		xsWant[i] = v
+		xsGot[i] = v
+	}
+
+	scratch := make([]uint32, n)
+	BatchInvMont(xsWant, scratch)
+
+	scratchFanout := make([]uint32, 2*n)
+	BatchInvMontTreeFanout(xsGot, scratchFanout, 4)
+
+	for i := 0; i < n; i++ {
+		if xsWant[i] != xsGot[i] {
+			t.Fatalf("index %d: BatchInvMont=%d BatchInvMontTreeFanout=%d", i, xsWant[i], xsGot[i])
+		}
+	}
+}
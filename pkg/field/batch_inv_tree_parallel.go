@@ -0,0 +1,58 @@
+package field
+
+import (
+	"runtime"
+	"sync"
+)
+
+// BatchInvTreeMont is BatchInvTreeCondPlain's Montgomery-form counterpart:
+// same zero-conditioning contract (zero entries stay zero), but operating
+// on Montgomery-form inputs/outputs the way BatchInvMont does. It routes to
+// BatchInvMontTreeCond, which already implements the segment-tree up-sweep/
+// down-sweep this name promises — this wrapper exists so callers reaching
+// for "the Montgomery tree, matching BatchInvTreeCondPlain's name" find it.
+//
+// scratch must have length >= 3*len(xs) and must not alias xs.
+func BatchInvTreeMont(xs []uint32, scratch []uint32) {
+	BatchInvMontTreeCond(xs, scratch)
+}
+
+// BatchInvTreeParallel is BatchInvTreeMont's goroutine-parallel counterpart,
+// targeted at bulk prover-side workloads (millions of elements) where a
+// single goroutine's O(log n) tree depth is still bounded by n's total
+// multiplications. It partitions xs into runtime.NumCPU() independent
+// chunks and runs BatchInvMontTree on each in its own goroutine — every
+// chunk pays its own single inversion (P inversions instead of 1 for P
+// chunks), trading a handful of extra Inv calls for true parallelism,
+// since chunks share no state and need no combining step.
+func BatchInvTreeParallel(xs []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	numWorkers := runtime.NumCPU()
+	if numWorkers > n {
+		numWorkers = n
+	}
+	if numWorkers <= 1 {
+		BatchInvMontTree(xs, make([]uint32, 3*n))
+		return
+	}
+
+	chunkSize := (n + numWorkers - 1) / numWorkers
+
+	var wg sync.WaitGroup
+	for start := 0; start < n; start += chunkSize {
+		end := start + chunkSize
+		if end > n {
+			end = n
+		}
+		wg.Add(1)
+		go func(chunk []uint32) {
+			defer wg.Done()
+			BatchInvMontTree(chunk, make([]uint32, 3*len(chunk)))
+		}(xs[start:end])
+	}
+	wg.Wait()
+}
@@ -0,0 +1,76 @@
+package field
+
+import "testing"
+
+// buildCase returns a length-n input with a scattered zero pattern (every
+// third element, plus i==0 when n>0) and its expected inverses per plain
+// BatchInv.
+func buildCase(n int) (xs, want []uint32) {
+	xs = make([]uint32, n)
+	for i := 0; i < n; i++ {
+		v := uint32((i*2654435761 + 12345) % Q)
+		if i%3 == 0 {
+			v = 0
+		}
+		xs[i] = v
+	}
+	want = append([]uint32(nil), xs...)
+	BatchInv(want)
+	return xs, want
+}
+
+func TestBatchInvTreeCondPlainAcrossSizes(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 35, 1024} {
+		xs, want := buildCase(n)
+		BatchInvTreeCondPlain(xs, make([]uint32, 3*n+8))
+		for i := range xs {
+			if xs[i] != want[i] {
+				t.Fatalf("n=%d: BatchInvTreeCondPlain[%d] = %d, want %d", n, i, xs[i], want[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvTreeMontAcrossSizes(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 35, 1024} {
+		xs, want := buildCase(n)
+		xsM := make([]uint32, n)
+		for i, x := range xs {
+			if x != 0 {
+				xsM[i] = ToMont(x)
+			}
+		}
+		BatchInvTreeMont(xsM, make([]uint32, 3*n+8))
+		for i := range xsM {
+			got := xsM[i]
+			if got != 0 {
+				got = FromMont(got)
+			}
+			if got != want[i] {
+				t.Fatalf("n=%d: BatchInvTreeMont[%d] = %d, want %d", n, i, got, want[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvTreeParallelAcrossSizes(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 35, 1024} {
+		xs, want := buildCase(n)
+		xsM := make([]uint32, n)
+		for i, x := range xs {
+			if x != 0 {
+				xsM[i] = ToMont(x)
+			}
+		}
+		BatchInvTreeParallel(xsM)
+		for i := range xsM {
+			got := xsM[i]
+			if got != 0 {
+				got = FromMont(got)
+			}
+			if got != want[i] {
+				t.Fatalf("n=%d: BatchInvTreeParallel[%d] = %d, want %d", n, i, got, want[i])
+			}
+		}
+	}
+}
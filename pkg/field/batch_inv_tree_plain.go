@@ -2,19 +2,53 @@ package field
 
 // batchInvTreeNoZeroILP4_35PlainLazyProd is a plain-domain batch inversion
 // specialized for n=35. It keeps intermediates lazy and writes strict outputs.
+//
+// This needs no separate purego/generic split of its own: every multiply
+// here goes through mulPlainLazy/mulPlainLazy2/mulPlainLazy8/mulPlainLazy16
+// (see plainlazy_purego.go / plainlazy_generic.go), so a future SIMD or
+// assembly backend for those carries this tree along for free without
+// further changes here. The widest layers (17 independent products in the
+// up-sweep's first layer and its mirror in the down-sweep's last layer)
+// batch through mulPlainLazy16 when montVectorWidth (cpu.go) reports an
+// AVX-512F machine, falling back to two mulPlainLazy8 calls otherwise; the
+// narrower layers in between (9, 4, 2, 1, 1 independent products) stay on
+// mulPlainLazy2/mulPlainLazy.
 func batchInvTreeNoZeroILP4_35PlainLazyProd(xs []uint32, scratch []uint32) {
 	x := (*[PosT]uint32)(xs)    // 35
 	s := (*[38]uint32)(scratch) // 18+9+5+3+2+1
 
 	// ============ UP-SWEEP ============
-	s[0], s[1] = mulPlainLazy2(x[0], x[1], x[2], x[3])
-	s[2], s[3] = mulPlainLazy2(x[4], x[5], x[6], x[7])
-	s[4], s[5] = mulPlainLazy2(x[8], x[9], x[10], x[11])
-	s[6], s[7] = mulPlainLazy2(x[12], x[13], x[14], x[15])
-	s[8], s[9] = mulPlainLazy2(x[16], x[17], x[18], x[19])
-	s[10], s[11] = mulPlainLazy2(x[20], x[21], x[22], x[23])
-	s[12], s[13] = mulPlainLazy2(x[24], x[25], x[26], x[27])
-	s[14], s[15] = mulPlainLazy2(x[28], x[29], x[30], x[31])
+	// Layer 0 has 17 independent products x[2i]*x[2i+1], i in [0,16]: wide
+	// enough to batch into a single 16-lane mulPlainLazy16 call on an
+	// AVX-512F machine, or two 8-lane mulPlainLazy8 calls otherwise. Deeper
+	// layers (9, 4, 2, 1, 1 independent products) stay on
+	// mulPlainLazy2/mulPlainLazy.
+	if montVectorWidth >= 16 {
+		a := [16]uint32{
+			x[0], x[4], x[8], x[12], x[16], x[20], x[24], x[28],
+			x[2], x[6], x[10], x[14], x[18], x[22], x[26], x[30],
+		}
+		b := [16]uint32{
+			x[1], x[5], x[9], x[13], x[17], x[21], x[25], x[29],
+			x[3], x[7], x[11], x[15], x[19], x[23], x[27], x[31],
+		}
+		r := mulPlainLazy16(&a, &b)
+		s[0], s[2], s[4], s[6], s[8], s[10], s[12], s[14] = r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]
+		s[1], s[3], s[5], s[7], s[9], s[11], s[13], s[15] = r[8], r[9], r[10], r[11], r[12], r[13], r[14], r[15]
+	} else {
+		{
+			a := [8]uint32{x[0], x[4], x[8], x[12], x[16], x[20], x[24], x[28]}
+			b := [8]uint32{x[1], x[5], x[9], x[13], x[17], x[21], x[25], x[29]}
+			r := mulPlainLazy8(&a, &b)
+			s[0], s[2], s[4], s[6], s[8], s[10], s[12], s[14] = r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]
+		}
+		{
+			a := [8]uint32{x[2], x[6], x[10], x[14], x[18], x[22], x[26], x[30]}
+			b := [8]uint32{x[3], x[7], x[11], x[15], x[19], x[23], x[27], x[31]}
+			r := mulPlainLazy8(&a, &b)
+			s[1], s[3], s[5], s[7], s[9], s[11], s[13], s[15] = r[0], r[1], r[2], r[3], r[4], r[5], r[6], r[7]
+		}
+	}
 	s[16] = mulPlainLazy(x[32], x[33])
 	s[17] = x[34]
 
@@ -128,141 +162,83 @@ func batchInvTreeNoZeroILP4_35PlainLazyProd(xs []uint32, scratch []uint32) {
 	rightVal = s[17]
 	s[16], s[17] = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
 
-	// Final layer writes strictly reduced values.
-	var out0, out1 uint32
-
-	parentInv = s[0]
-	leftVal = x[0]
-	rightVal = x[1]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[0], x[1] = reduce2(out0, out1)
-
-	parentInv = s[1]
-	leftVal = x[2]
-	rightVal = x[3]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[2], x[3] = reduce2(out0, out1)
-
-	parentInv = s[2]
-	leftVal = x[4]
-	rightVal = x[5]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[4], x[5] = reduce2(out0, out1)
-
-	parentInv = s[3]
-	leftVal = x[6]
-	rightVal = x[7]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[6], x[7] = reduce2(out0, out1)
-
-	parentInv = s[4]
-	leftVal = x[8]
-	rightVal = x[9]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[8], x[9] = reduce2(out0, out1)
-
-	parentInv = s[5]
-	leftVal = x[10]
-	rightVal = x[11]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[10], x[11] = reduce2(out0, out1)
-
-	parentInv = s[6]
-	leftVal = x[12]
-	rightVal = x[13]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[12], x[13] = reduce2(out0, out1)
-
-	parentInv = s[7]
-	leftVal = x[14]
-	rightVal = x[15]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[14], x[15] = reduce2(out0, out1)
-
-	parentInv = s[8]
-	leftVal = x[16]
-	rightVal = x[17]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[16], x[17] = reduce2(out0, out1)
-
-	parentInv = s[9]
-	leftVal = x[18]
-	rightVal = x[19]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[18], x[19] = reduce2(out0, out1)
-
-	parentInv = s[10]
-	leftVal = x[20]
-	rightVal = x[21]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[20], x[21] = reduce2(out0, out1)
-
-	parentInv = s[11]
-	leftVal = x[22]
-	rightVal = x[23]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[22], x[23] = reduce2(out0, out1)
-
-	parentInv = s[12]
-	leftVal = x[24]
-	rightVal = x[25]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[24], x[25] = reduce2(out0, out1)
-
-	parentInv = s[13]
-	leftVal = x[26]
-	rightVal = x[27]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[26], x[27] = reduce2(out0, out1)
-
-	parentInv = s[14]
-	leftVal = x[28]
-	rightVal = x[29]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[28], x[29] = reduce2(out0, out1)
-
-	parentInv = s[15]
-	leftVal = x[30]
-	rightVal = x[31]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[30], x[31] = reduce2(out0, out1)
-
-	parentInv = s[16]
-	leftVal = x[32]
-	rightVal = x[33]
-	out0, out1 = mulPlainLazy2(parentInv, rightVal, parentInv, leftVal)
-	x[32], x[33] = reduce2(out0, out1)
-
-	x[34] = reduce(s[17])
-}
-
-// batchInvTreeWithZeroILP4_35PlainLazyProd handles zeros via the standard
-// "replace zero with one" trick on a local working copy, while preserving zero
-// outputs at the corresponding positions.
-func batchInvTreeWithZeroILP4_35PlainLazyProd(xs []uint32, scratch []uint32) {
-	work := scratch[:PosT]
-	treeScratch := scratch[PosT:]
-
-	for i := 0; i < PosT; i++ {
-		v := xs[i]
-		if v == 0 {
-			work[i] = 1
-		} else {
-			work[i] = v
+	// Final layer writes strictly reduced values. It has 17 pairs, 34
+	// independent multiplies total (parentInv*rightVal and
+	// parentInv*leftVal per pair) — flatten across pair boundaries and
+	// batch through mulPlainLazy16 when montVectorWidth reports 16 lanes and
+	// mulPlainLazy8 otherwise, with a scalar remainder.
+	var aAll, bAll [34]uint32
+	for i := 0; i < 17; i++ {
+		aAll[2*i], bAll[2*i] = s[i], x[2*i+1]
+		aAll[2*i+1], bAll[2*i+1] = s[i], x[2*i]
+	}
+	var outAll [34]uint32
+	off := 0
+	if montVectorWidth >= 16 {
+		for ; off+16 <= len(aAll); off += 16 {
+			a := (*[16]uint32)(aAll[off : off+16])
+			b := (*[16]uint32)(bAll[off : off+16])
+			r := mulPlainLazy16(a, b)
+			copy(outAll[off:off+16], r[:])
 		}
 	}
+	for ; off+8 <= len(aAll); off += 8 {
+		a := (*[8]uint32)(aAll[off : off+8])
+		b := (*[8]uint32)(bAll[off : off+8])
+		r := mulPlainLazy8(a, b)
+		copy(outAll[off:off+8], r[:])
+	}
+	for ; off < len(aAll); off++ {
+		outAll[off] = mulPlainLazy(aAll[off], bAll[off])
+	}
+	for i := 0; i < 17; i++ {
+		x[2*i], x[2*i+1] = reduce2(outAll[2*i], outAll[2*i+1])
+	}
 
-	batchInvTreeNoZeroILP4_35PlainLazyProd(work, treeScratch)
+	x[34] = reduce(s[17])
+}
 
-	for i := 0; i < PosT; i++ {
-		if xs[i] != 0 {
-			xs[i] = work[i]
-		}
+// batchInvTreePlainSpecialized dispatches to the hand-written (n=PosT) or
+// pkg/field/internal/gen-generated (everything else here) fixed-size plain
+// tree for an exact-length match, or reports false so the caller can fall
+// back to the runtime-loop BatchInvTree. xs must already be zero-free
+// (callers condition zeros out via the usual "replace zero with one" trick
+// before calling, same as the PosT/PosRate branches did before this split).
+func batchInvTreePlainSpecialized(xs []uint32, scratch []uint32) bool {
+	switch len(xs) {
+	case PosT:
+		batchInvTreeNoZeroILP4_35PlainLazyProd(xs, scratch)
+	case 8:
+		batchInvTreeILP2_8PlainLazyProd(xs, scratch)
+	case 16:
+		batchInvTreeILP2_16PlainLazyProd(xs, scratch)
+	case PosRate: // 24
+		batchInvTreeILP2_24PlainLazyProd(xs, scratch)
+	case 32:
+		batchInvTreeILP2_32PlainLazyProd(xs, scratch)
+	case 64:
+		batchInvTreeILP2_64PlainLazyProd(xs, scratch)
+	case 128:
+		batchInvTreeILP2_128PlainLazyProd(xs, scratch)
+	case 256:
+		batchInvTreeILP2_256PlainLazyProd(xs, scratch)
+	default:
+		return false
 	}
+	return true
 }
 
 // BatchInvTreeCondPlain performs batch inversion in plain field representation.
-// Zero entries remain zero.
+// Zero entries remain zero. Dispatches to a specialized generated tree
+// routine (see pkg/field/internal/gen) when one matches len(xs) — today
+// n=PosT=35 (hand-written) and n=8,16,24,32,64,128,256 (generated) — and
+// falls back to the generic runtime-loop BatchInvTree for every other
+// length. Add a size by running the gen tool and a case in
+// batchInvTreePlainSpecialized; nothing else here needs to change.
+// scratch must have capacity >= 4*n to cover the zero-conditioned path's
+// work buffer plus whichever tree (specialized or generic) runs underneath
+// it; the zero-free path needs less but callers should just size for the
+// worst case.
 func BatchInvTreeCondPlain(xs []uint32, scratch []uint32) {
 	n := len(xs)
 	if n == 0 {
@@ -275,22 +251,6 @@ func BatchInvTreeCondPlain(xs []uint32, scratch []uint32) {
 		return
 	}
 
-	if n == PosT {
-		hasZero := false
-		for i := 0; i < n; i++ {
-			if xs[i] == 0 {
-				hasZero = true
-				break
-			}
-		}
-		if hasZero {
-			batchInvTreeWithZeroILP4_35PlainLazyProd(xs, scratch)
-		} else {
-			batchInvTreeNoZeroILP4_35PlainLazyProd(xs, scratch)
-		}
-		return
-	}
-
 	hasZero := false
 	for i := 0; i < n; i++ {
 		if xs[i] == 0 {
@@ -299,10 +259,28 @@ func BatchInvTreeCondPlain(xs []uint32, scratch []uint32) {
 		}
 	}
 
-	if hasZero {
-		BatchInv(xs)
+	if !hasZero {
+		if batchInvTreePlainSpecialized(xs, scratch) {
+			return
+		}
+		BatchInvTree(xs, scratch)
 		return
 	}
 
-	BatchInv(xs)
+	work := scratch[:n]
+	for i := 0; i < n; i++ {
+		if xs[i] == 0 {
+			work[i] = 1
+		} else {
+			work[i] = xs[i]
+		}
+	}
+	if !batchInvTreePlainSpecialized(work, scratch[n:]) {
+		BatchInvTree(work, scratch[n:])
+	}
+	for i := 0; i < n; i++ {
+		if xs[i] != 0 {
+			xs[i] = work[i]
+		}
+	}
 }
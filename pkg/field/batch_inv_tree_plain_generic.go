@@ -0,0 +1,104 @@
+package field
+
+// BatchInvTree is BatchInvMontTree's plain-domain counterpart: the same
+// tree-based algorithm (O(log n) depth instead of BatchInv's O(n) sequential
+// depth), but operating on plain-domain field elements via mulPlainLazy and
+// invPlainLazy instead of Montgomery-domain mulMontLazy and InvMont. It is
+// the runtime-loop fallback BatchInvTreeCondPlain dispatches to for lengths
+// with no hand-written or pkg/field/internal/gen-generated specialization.
+// scratch must have capacity >= 3*n.
+func BatchInvTree(xs []uint32, scratch []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	if n == 1 {
+		if xs[0] != 0 {
+			xs[0] = invPlainLazy(xs[0])
+		}
+		return
+	}
+
+	// Copy inputs to working buffer, handling zeros
+	work := scratch[:n]
+	for i := 0; i < n; i++ {
+		x := xs[i]
+		if x == 0 {
+			work[i] = 1 // Use plain-domain 1 for zeros
+		} else {
+			work[i] = x
+		}
+	}
+
+	// Calculate layers needed (max 8 for n<=256, 10 for n<=1024)
+	maxLayers := 0
+	for temp := n; temp > 1; temp = (temp + 1) / 2 {
+		maxLayers++
+	}
+
+	// Layer storage: fixed-size array to avoid allocation
+	var layerOff [16]int
+	var layerCnt [16]int
+
+	layerOff[0] = 0
+	layerCnt[0] = n
+
+	offset := n
+	currentCount := n
+	for l := 1; l <= maxLayers; l++ {
+		nextCount := (currentCount + 1) / 2
+		layerOff[l] = offset
+		layerCnt[l] = nextCount
+		offset += nextCount
+		currentCount = nextCount
+	}
+
+	// ============ UP-SWEEP ============
+	for l := 0; l < maxLayers; l++ {
+		srcOff := layerOff[l]
+		srcCnt := layerCnt[l]
+		dstOff := layerOff[l+1]
+
+		pairs := srcCnt / 2
+		for p := 0; p < pairs; p++ {
+			scratch[dstOff+p] = mulPlainLazy(scratch[srcOff+p*2], scratch[srcOff+p*2+1])
+		}
+
+		if srcCnt%2 == 1 {
+			scratch[dstOff+pairs] = scratch[srcOff+srcCnt-1]
+		}
+	}
+
+	// ============ INVERT ROOT ============
+	rootOff := layerOff[maxLayers]
+	scratch[rootOff] = invPlainLazy(scratch[rootOff])
+
+	// ============ DOWN-SWEEP ============
+	for l := maxLayers; l > 0; l-- {
+		parentOff := layerOff[l]
+		childOff := layerOff[l-1]
+		childCnt := layerCnt[l-1]
+		pairs := childCnt / 2
+
+		for p := 0; p < pairs; p++ {
+			parentInv := scratch[parentOff+p]
+			leftVal := scratch[childOff+p*2]
+			rightVal := scratch[childOff+p*2+1]
+
+			scratch[childOff+p*2] = mulPlainLazy(parentInv, rightVal)
+			scratch[childOff+p*2+1] = mulPlainLazy(parentInv, leftVal)
+		}
+
+		if childCnt%2 == 1 {
+			scratch[childOff+childCnt-1] = scratch[parentOff+pairs]
+		}
+	}
+
+	// ============ WRITE BACK ============
+	for i := 0; i < n; i++ {
+		if xs[i] == 0 {
+			continue // Zero stays zero
+		}
+		xs[i] = reduce(work[i])
+	}
+}
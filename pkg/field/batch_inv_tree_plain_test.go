@@ -42,3 +42,74 @@ func TestBatchInvTreeCondPlainWithZeros(t *testing.T) {
 		}
 	}
 }
+
+func TestBatchInvTreeCondPlainMatchesBatchInvN24(t *testing.T) {
+	for iter := 0; iter < 2000; iter++ {
+		var xs0 [PosRate]uint32
+		var xs1 [PosRate]uint32
+		for i := 0; i < PosRate; i++ {
+			v := uint32((iter*977 + i*131 + 1) % int(Q))
+			if v == 0 {
+				v = 1
+			}
+			xs0[i] = v
+			xs1[i] = v
+		}
+
+		BatchInv(xs0[:])
+		scratch := make([]uint32, 128)
+		BatchInvTreeCondPlain(xs1[:], scratch)
+
+		for i := 0; i < PosRate; i++ {
+			if xs1[i] != xs0[i] {
+				t.Fatalf("iter=%d idx=%d got=%d want=%d", iter, i, xs1[i], xs0[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvTreeCondPlainGeneratedSizes(t *testing.T) {
+	for _, n := range []int{8, 16, 32, 64, 128, 256, 1000} {
+		xs0 := make([]uint32, n)
+		for i := 0; i < n; i++ {
+			v := uint32((i*2654435761 + 12345) % Q)
+			if i%7 == 0 {
+				v = 0
+			}
+			xs0[i] = v
+		}
+		xs1 := append([]uint32(nil), xs0...)
+
+		BatchInv(xs0)
+		scratch := make([]uint32, 4*n+8)
+		BatchInvTreeCondPlain(xs1, scratch)
+
+		for i := 0; i < n; i++ {
+			if xs1[i] != xs0[i] {
+				t.Fatalf("n=%d idx=%d got=%d want=%d", n, i, xs1[i], xs0[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvTreeCondPlainN24WithZeros(t *testing.T) {
+	var xs0 [PosRate]uint32
+	for i := range xs0 {
+		if i%5 == 0 {
+			xs0[i] = 0
+		} else {
+			xs0[i] = uint32(i*131+1) % Q
+		}
+	}
+	xs1 := xs0
+
+	BatchInv(xs0[:])
+	scratch := make([]uint32, 128)
+	BatchInvTreeCondPlain(xs1[:], scratch)
+
+	for i := range xs0 {
+		if xs1[i] != xs0[i] {
+			t.Fatalf("idx=%d got=%d want=%d", i, xs1[i], xs0[i])
+		}
+	}
+}
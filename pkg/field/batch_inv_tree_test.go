@@ -103,6 +103,107 @@ func TestBatchInvMontTreeNoZeroILP4(t *testing.T) {
 	}
 }
 
+func TestMulMontLazy8Matches(t *testing.T) {
+	x := uint32(1)
+	y := uint32(2)
+	for i := 0; i < 100000; i++ {
+		var a, b [8]uint32
+		for lane := 0; lane < 8; lane++ {
+			x = x*1664525 + 1013904223
+			y = y*22695477 + 1
+			a[lane] = x % (2 * Q)
+			b[lane] = y % (2 * Q)
+		}
+
+		got := mulMontLazy8(&a, &b)
+		for lane := 0; lane < 8; lane++ {
+			want := mulMontLazy(a[lane], b[lane])
+			if got[lane] != want {
+				t.Fatalf("lane%d a=%d b=%d got=%d want=%d", lane, a[lane], b[lane], got[lane], want)
+			}
+		}
+	}
+}
+
+func TestMulMontLazy16Matches(t *testing.T) {
+	x := uint32(1)
+	y := uint32(2)
+	for i := 0; i < 100000; i++ {
+		var a, b [16]uint32
+		for lane := 0; lane < 16; lane++ {
+			x = x*1664525 + 1013904223
+			y = y*22695477 + 1
+			a[lane] = x % (2 * Q)
+			b[lane] = y % (2 * Q)
+		}
+
+		got := mulMontLazy16(&a, &b)
+		for lane := 0; lane < 16; lane++ {
+			want := mulMontLazy(a[lane], b[lane])
+			if got[lane] != want {
+				t.Fatalf("lane%d a=%d b=%d got=%d want=%d", lane, a[lane], b[lane], got[lane], want)
+			}
+		}
+	}
+}
+
+func TestBatchInvMontTreeNoZeroILP4GeneratedSizesMatch(t *testing.T) {
+	// Sizes with a generated batchInvMontTree_<n> specialization (see
+	// pkg/field/internal/gen and the batch_inv_tree_n*_mont.go files it
+	// produced) that BatchInvMontTreeNoZeroILP4 dispatches to directly.
+	sizes := []int{8, 12, 16, 24, 32, 48, 64, 128, 256}
+
+	for _, n := range sizes {
+		xs1 := make([]uint32, n)
+		xs2 := make([]uint32, n)
+		for i := 0; i < n; i++ {
+			xs1[i] = ToMont(uint32(i + 1))
+			xs2[i] = ToMont(uint32(i + 1))
+		}
+
+		scratch1 := make([]uint32, 3*n)
+		scratch2 := make([]uint32, 3*n)
+
+		BatchInvMontTreeNoZero(xs1, scratch1)
+		BatchInvMontTreeNoZeroILP4(xs2, scratch2)
+
+		for i := 0; i < n; i++ {
+			if xs1[i] != xs2[i] {
+				t.Errorf("size %d, index %d: NoZero=%d, ILP4(generated)=%d", n, i, xs1[i], xs2[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvMontDispatchMatchesTreeCond(t *testing.T) {
+	sizes := []int{0, 1, 2, 4, 7, 8, 9, 35, 63, 64, 65, 200}
+
+	for _, n := range sizes {
+		xs1 := make([]uint32, n)
+		xs2 := make([]uint32, n)
+		for i := 0; i < n; i++ {
+			v := ToMont(uint32(i + 1))
+			if i%7 == 3 {
+				v = 0 // exercise the zero path too
+			}
+			xs1[i] = v
+			xs2[i] = v
+		}
+
+		scratch1 := make([]uint32, 3*n+8)
+		scratch2 := make([]uint32, 3*n+8)
+
+		BatchInvMontTreeCond(xs1, scratch1)
+		BatchInvMontDispatch(xs2, scratch2)
+
+		for i := 0; i < n; i++ {
+			if xs1[i] != xs2[i] {
+				t.Errorf("size %d, index %d: TreeCond=%d, Dispatch=%d", n, i, xs1[i], xs2[i])
+			}
+		}
+	}
+}
+
 func TestBatchInvMontTreeWithZeros(t *testing.T) {
 	n := 35
 	xs := make([]uint32, n)
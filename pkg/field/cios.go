@@ -0,0 +1,63 @@
+package field
+
+// mulMontCIOS computes a*b*R^-1 mod Q (R = 2^32) via the classic CIOS
+// (Coarsely Integrated Operand Scanning) recurrence: t = a*b; m = (t * q')
+// mod 2^32 with q' = -Q^-1 mod 2^32; t = (t + m*Q) / 2^32; conditional
+// subtract Q. With R equal to a single 32-bit limb, CIOS' usual multi-limb
+// loop degenerates to exactly one step — this is mathematically identical
+// to MulMont/MontReduce's REDC step, just spelled out with CIOS' own
+// variable names for anyone looking for the textbook algorithm by name.
+func mulMontCIOS(a, b uint32) uint32 {
+	t := uint64(a) * uint64(b)
+	m := uint32(t) * montgomeryQInvNeg // q' = -Q^-1 mod 2^32
+	u := (t + uint64(m)*Q) >> 32
+	if u >= Q {
+		u -= Q
+	}
+	return uint32(u)
+}
+
+// mulMontCIOS4 computes four independent Montgomery products, with the two
+// 32x32->64 multiplies of each lane interleaved across all four lanes (all
+// four t's, then all four m's, then all four reductions) instead of
+// computing one lane's product-then-reduce before starting the next, so a
+// superscalar core can dual-issue the independent multiplies.
+func mulMontCIOS4(a0, b0, a1, b1, a2, b2, a3, b3 uint32) (uint32, uint32, uint32, uint32) {
+	t0 := uint64(a0) * uint64(b0)
+	t1 := uint64(a1) * uint64(b1)
+	t2 := uint64(a2) * uint64(b2)
+	t3 := uint64(a3) * uint64(b3)
+
+	m0 := uint32(t0) * montgomeryQInvNeg
+	m1 := uint32(t1) * montgomeryQInvNeg
+	m2 := uint32(t2) * montgomeryQInvNeg
+	m3 := uint32(t3) * montgomeryQInvNeg
+
+	u0 := (t0 + uint64(m0)*Q) >> 32
+	u1 := (t1 + uint64(m1)*Q) >> 32
+	u2 := (t2 + uint64(m2)*Q) >> 32
+	u3 := (t3 + uint64(m3)*Q) >> 32
+
+	if u0 >= Q {
+		u0 -= Q
+	}
+	if u1 >= Q {
+		u1 -= Q
+	}
+	if u2 >= Q {
+		u2 -= Q
+	}
+	if u3 >= Q {
+		u3 -= Q
+	}
+	return uint32(u0), uint32(u1), uint32(u2), uint32(u3)
+}
+
+// MontMul is the public name for Montgomery multiplication: a*b*R^-1 mod Q
+// for R = 2^32. It's the published entry point alongside ToMont/FromMont;
+// MulMont is kept as-is for existing callers in this package (both compute
+// the same thing — MontMul just routes through mulMontCIOS, named for the
+// textbook algorithm it implements).
+func MontMul(a, b uint32) uint32 {
+	return mulMontCIOS(a, b)
+}
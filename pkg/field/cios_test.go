@@ -0,0 +1,39 @@
+package field
+
+import "testing"
+
+func TestMulMontCIOSMatchesMulMont(t *testing.T) {
+	for a := uint32(0); a < 500; a++ {
+		aM := ToMont(a)
+		for b := uint32(0); b < 50; b++ {
+			bM := ToMont(b)
+			if got, want := mulMontCIOS(aM, bM), MulMont(aM, bM); got != want {
+				t.Fatalf("mulMontCIOS(%d,%d) = %d, want %d", aM, bM, got, want)
+			}
+		}
+	}
+}
+
+func TestMontMulMatchesMulMont(t *testing.T) {
+	for a := uint32(1); a < 1000; a += 7 {
+		for b := uint32(1); b < 1000; b += 11 {
+			aM, bM := ToMont(a), ToMont(b)
+			if got, want := MontMul(aM, bM), MulMont(aM, bM); got != want {
+				t.Fatalf("MontMul(%d,%d) = %d, want %d", aM, bM, got, want)
+			}
+		}
+	}
+}
+
+func TestMulMontCIOS4MatchesScalar(t *testing.T) {
+	as := [4]uint32{ToMont(3), ToMont(1234), ToMont(Q - 1), ToMont(0)}
+	bs := [4]uint32{ToMont(7), ToMont(4321), ToMont(2), ToMont(999)}
+
+	r0, r1, r2, r3 := mulMontCIOS4(as[0], bs[0], as[1], bs[1], as[2], bs[2], as[3], bs[3])
+	got := [4]uint32{r0, r1, r2, r3}
+	for i := range as {
+		if want := MulMont(as[i], bs[i]); got[i] != want {
+			t.Fatalf("lane %d: mulMontCIOS4 = %d, want %d", i, got[i], want)
+		}
+	}
+}
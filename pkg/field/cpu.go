@@ -0,0 +1,40 @@
+//go:build !purego
+
+package field
+
+import "golang.org/x/sys/cpu"
+
+// hasVectorMont reports whether the current CPU has the wide-lane integer
+// multiply support (AVX2 on amd64, NEON on arm64) a vectorized mulMontLazy
+// backend could dispatch to. No such backend exists — mont_lazy_generic.go
+// is plain scalar Go — so this is unused today; it's CPU-detection
+// scaffolding, not a sign a SIMD kernel is in flight.
+var hasVectorMont = detectHasVectorMont()
+
+func detectHasVectorMont() bool {
+	return cpu.X86.HasAVX2 || cpu.ARM64.HasASIMD
+}
+
+// montVectorWidth is the lane count BatchInvMontTreeNoZeroILP4 (Montgomery
+// domain) and batchInvTreeNoZeroILP4_35PlainLazyProd (plain domain) batch
+// their up-sweep/down-sweep multiplies into: 16 on AVX-512F, 8 on
+// AVX2/NEON, or 1 (the existing scalar 4-pair unroll) otherwise. The
+// name predates the plain-domain caller — it's a CPU capability check, not
+// a Montgomery-specific one. mulMontLazy8/mulMontLazy16/mulPlainLazy8/
+// mulPlainLazy16 are themselves still scalar loops with no vector kernel
+// behind them, so today this only changes the loops' iteration shape —
+// but it is the dispatch point a real kernel could key off of, if one is
+// ever written. See cpu_purego.go for the -tags=purego counterpart, which
+// is always 1.
+var montVectorWidth = detectMontVectorWidth()
+
+func detectMontVectorWidth() int {
+	switch {
+	case cpu.X86.HasAVX512F:
+		return 16
+	case cpu.X86.HasAVX2, cpu.ARM64.HasASIMD:
+		return 8
+	default:
+		return 1
+	}
+}
@@ -0,0 +1,9 @@
+//go:build purego
+
+package field
+
+// montVectorWidth is always 1 (scalar) under -tags=purego: no CPU feature
+// detection, no vector dispatch. See cpu.go for the !purego version that
+// BatchInvMontTreeNoZeroILP4 and batchInvTreeNoZeroILP4_35PlainLazyProd
+// use to pick their batch width.
+const montVectorWidth = 1
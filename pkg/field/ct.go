@@ -0,0 +1,254 @@
+package field
+
+// InvCT is a constant-time variant of Inv: the same fixed addition-chain
+// Fermat exponentiation, but without Inv's `if a == 0` early return. That
+// branch is a timing leak when a is secret (e.g. a polynomial coefficient
+// mid-signature) — it lets an attacker who can measure wall-clock time
+// distinguish "was zero" from "wasn't zero". The chain below is branch-free
+// and already maps 0 to 0 on its own (every Mul(0, x) is 0, so the whole
+// chain collapses to 0 for a == 0 without needing to special-case it), so
+// dropping the branch costs nothing but correctness risk.
+func InvCT(a uint32) uint32 {
+	x2 := Mul(a, a)
+	x3 := Mul(x2, a)
+	x6 := Mul(x3, x3)
+	x12 := Mul(x6, x6)
+	x15 := Mul(x12, x3)
+
+	res := x6
+	for i := 0; i < 5; i++ {
+		res = Mul(res, res)
+		res = Mul(res, res)
+		res = Mul(res, res)
+		res = Mul(res, res)
+		res = Mul(res, x15)
+	}
+	return res
+}
+
+// InvMontCT is InvCT's Montgomery-form counterpart: InvMont's addition
+// chain without the `if aM == 0` early return, for the same reason (used
+// wherever the root inversion in a batch-inversion pass touches secret
+// data, e.g. BatchInvMontParallel).
+func InvMontCT(aM uint32) uint32 {
+	_10 := mulMontLazy(aM, aM)
+	_11 := mulMontLazy(aM, _10)
+	_1100 := mulMontLazy(_11, _11)
+	_1100 = mulMontLazy(_1100, _1100)
+	_1111 := mulMontLazy(_11, _1100)
+	_1100000 := mulMontLazy(_1100, _1100)
+	_1100000 = mulMontLazy(_1100000, _1100000)
+	_1100000 = mulMontLazy(_1100000, _1100000)
+	_1101111 := mulMontLazy(_1111, _1100000)
+
+	i23 := mulMontLazy(_1101111, _1101111)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, _1111)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, _1111)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+	i23 = mulMontLazy(i23, i23)
+
+	res := mulMontLazy(_1111, i23)
+	res = mulMontLazy(res, res)
+	res = mulMontLazy(res, res)
+	res = mulMontLazy(res, res)
+	res = mulMontLazy(res, res)
+	res = mulMontLazy(res, _1111)
+
+	return reduce(res)
+}
+
+// invPlainLazyCT is invPlainLazy's addition chain without the `if a == 0`
+// early return, for the same reason as InvCT/InvMontCT (used by the
+// plain-domain tree's root inversion in BatchInvTreeCondPlainCT).
+func invPlainLazyCT(a uint32) uint32 {
+	_10 := mulPlainLazy(a, a)
+	_11 := mulPlainLazy(a, _10)
+	_1100 := mulPlainLazy(_11, _11)
+	_1100 = mulPlainLazy(_1100, _1100)
+	_1111 := mulPlainLazy(_11, _1100)
+	_1100000 := mulPlainLazy(_1100, _1100)
+	_1100000 = mulPlainLazy(_1100000, _1100000)
+	_1100000 = mulPlainLazy(_1100000, _1100000)
+	_1101111 := mulPlainLazy(_1111, _1100000)
+
+	i23 := mulPlainLazy(_1101111, _1101111)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, _1111)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, _1111)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+	i23 = mulPlainLazy(i23, i23)
+
+	res := mulPlainLazy(_1111, i23)
+	res = mulPlainLazy(res, res)
+	res = mulPlainLazy(res, res)
+	res = mulPlainLazy(res, res)
+	res = mulPlainLazy(res, res)
+	res = mulPlainLazy(res, _1111)
+
+	return reduce(res)
+}
+
+// eqMask32 returns 0xFFFFFFFF if a == b, else 0, without a data-dependent
+// branch — the same technique crypto/subtle.ConstantTimeEq uses, widened
+// to a full mask instead of a 0/1 int.
+func eqMask32(a, b uint32) uint32 {
+	diff := uint64(a ^ b)
+	bit := uint32((diff - 1) >> 63) // 1 if a==b, 0 otherwise
+	return -bit
+}
+
+// CtReduce reduces r (assumed < 2*Q) into [0, Q) without the
+// `if r >= Q { r -= Q }` branch Add/Sub/MulMont use, via a signed-shift
+// mask instead.
+func CtReduce(r uint32) uint32 {
+	d := int32(r) - Q       // >= 0 iff r >= Q
+	keepMask := uint32(d >> 31) // all-ones iff r < Q (don't subtract)
+	return r - (Q &^ keepMask)
+}
+
+// CtSub returns (a - b) mod Q branchlessly: Sub's `if diff < 0 { diff +=
+// Q }` becomes a signed-shift mask instead.
+func CtSub(a, b uint32) uint32 {
+	d := int32(a) - int32(b)
+	borrowMask := uint32(d >> 31) // all-ones iff a < b
+	return uint32(d) + (Q & borrowMask)
+}
+
+// CtMul returns (a * b) mod Q for normal-form a, b, branchlessly. Unlike
+// Mul (a 64-bit multiply plus a hardware `% Q`, whose timing on some
+// architectures can depend on the operands), CtMul reduces via Montgomery
+// multiplication — itself branch-free except for one conditional
+// subtraction, which CtReduce replaces with mask arithmetic.
+func CtMul(a, b uint32) uint32 {
+	aM := ToMont(a)
+	t := uint64(aM) * uint64(b)
+	m := uint32(t) * montgomeryQInvNeg
+	u := (t + uint64(m)*Q) >> 32
+	return CtReduce(uint32(u))
+}
+
+// CtAdd returns (a + b) mod Q branchlessly: Add's `if sum >= Q { sum -= Q }`
+// becomes the same signed-shift mask CtReduce already uses.
+func CtAdd(a, b uint32) uint32 {
+	return CtReduce(a + b)
+}
+
+// CtNeg returns (-a) mod Q branchlessly: Neg's `if a == 0 { return 0 }`
+// becomes a mask that zeroes Q-a exactly when a is zero.
+func CtNeg(a uint32) uint32 {
+	keepMask := eqMask32(a, 0) // all-ones iff a == 0, forcing the result to 0
+	return (Q - a) &^ keepMask
+}
+
+// selectU32 is the constant-time multiplexer `select(mask, a, b) = b ^
+// ((a^b) & mask)`: it returns a if mask is all-ones, b if mask is zero, and
+// is used below wherever a data-dependent branch would otherwise choose
+// between two already-computed values.
+func selectU32(mask, a, b uint32) uint32 {
+	return b ^ ((a ^ b) & mask)
+}
+
+// CtBatchInv is BatchInv without its `if xs[i] == 0` branches: the root
+// inversion always runs InvCT's full chain (which already maps 0 to 0), and
+// each backward-pass step is computed unconditionally and then selected
+// against the original xs[i] with the zero mask instead of being skipped.
+func CtBatchInv(xs []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	prods := make([]uint32, n)
+	prods[0] = selectU32(eqMask32(xs[0], 0), 1, xs[0])
+	for i := 1; i < n; i++ {
+		zero := eqMask32(xs[i], 0)
+		prods[i] = selectU32(zero, prods[i-1], Mul(prods[i-1], xs[i]))
+	}
+
+	inv := InvCT(prods[n-1])
+
+	for i := n - 1; i > 0; i-- {
+		zero := eqMask32(xs[i], 0)
+		oldXi := xs[i]
+		xs[i] = selectU32(zero, 0, Mul(inv, prods[i-1]))
+		inv = selectU32(zero, inv, Mul(inv, oldXi))
+	}
+	xs[0] = selectU32(eqMask32(xs[0], 0), 0, inv)
+}
+
+// CtMulMont is MulMont without its final `if u >= Q { u -= Q }`, reusing
+// CtReduce's mask arithmetic instead.
+func CtMulMont(a, b uint32) uint32 {
+	t := uint64(a) * uint64(b)
+	m := uint32(t) * montgomeryQInvNeg
+	u := (t + uint64(m)*Q) >> 32
+	return CtReduce(uint32(u))
+}
+
+// CtBatchInvMont is BatchInvMont without its `if xs[i] == 0` branches,
+// following the same select-over-mask shape as CtBatchInv but in
+// Montgomery form throughout.
+//
+// WARNING: scratch must not alias xs and must have length >= len(xs), same
+// as BatchInvMont.
+func CtBatchInvMont(xs []uint32, scratch []uint32) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+
+	oneM := ToMont(1)
+	prods := scratch[:n]
+	prods[0] = selectU32(eqMask32(xs[0], 0), oneM, xs[0])
+	for i := 1; i < n; i++ {
+		zero := eqMask32(xs[i], 0)
+		prods[i] = selectU32(zero, prods[i-1], mulMontLazy(prods[i-1], xs[i]))
+	}
+
+	inv := InvMontCT(reduce(prods[n-1]))
+
+	for i := n - 1; i > 0; i-- {
+		zero := eqMask32(xs[i], 0)
+		oldXi := xs[i]
+		xs[i] = selectU32(zero, 0, CtMulMont(inv, prods[i-1]))
+		inv = selectU32(zero, inv, mulMontLazy(inv, oldXi))
+	}
+	xs[0] = selectU32(eqMask32(xs[0], 0), 0, reduce(inv))
+}
+
+// DecomposeCT is Decompose without its two data-dependent branches
+// (the `if r0 > Gamma2` range fix-up and the `if r-r0 == Q-1` boundary
+// case), both replaced with mask arithmetic. Gamma2 is a power of two, so
+// the `r % (2*Gamma2)` step was already branch-free.
+func DecomposeCT(r uint32) (r0 int32, r1 uint32) {
+	r0i := int32(r % (2 * Gamma2))
+
+	// if r0i > Gamma2 { r0i -= 2*Gamma2 }
+	over := r0i - Gamma2 - 1          // >= 0 iff r0i > Gamma2
+	subMask := ^(over >> 31)          // all-ones iff r0i > Gamma2
+	r0i -= int32(2*Gamma2) & subMask
+
+	diff := r - uint32(r0i)
+	boundary := eqMask32(diff, Q-1) // all-ones iff diff == Q-1
+
+	r0i -= int32(uint32(1) & boundary)
+	r1 = (diff / (2 * Gamma2)) &^ boundary
+	return r0i, r1
+}
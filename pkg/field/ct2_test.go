@@ -0,0 +1,60 @@
+package field
+
+import "testing"
+
+func TestCtReduceMatchesBranching(t *testing.T) {
+	for r := uint32(0); r < 2*Q; r++ {
+		want := r
+		if want >= Q {
+			want -= Q
+		}
+		if got := CtReduce(r); got != want {
+			t.Fatalf("CtReduce(%d) = %d, want %d", r, got, want)
+		}
+	}
+}
+
+func TestCtSubMatchesSub(t *testing.T) {
+	for a := uint32(0); a < 300; a++ {
+		for b := uint32(0); b < 300; b++ {
+			if got, want := CtSub(a, b), Sub(a, b); got != want {
+				t.Fatalf("CtSub(%d,%d) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+	if got, want := CtSub(0, Q-1), Sub(0, Q-1); got != want {
+		t.Fatalf("CtSub(0,Q-1) = %d, want %d", got, want)
+	}
+}
+
+func TestCtMulMatchesMul(t *testing.T) {
+	for a := uint32(0); a < 300; a++ {
+		for b := uint32(0); b < 50; b++ {
+			if got, want := CtMul(a, b), Mul(a, b); got != want {
+				t.Fatalf("CtMul(%d,%d) = %d, want %d", a, b, got, want)
+			}
+		}
+	}
+	if got, want := CtMul(Q-1, Q-1), Mul(Q-1, Q-1); got != want {
+		t.Fatalf("CtMul(Q-1,Q-1) = %d, want %d", got, want)
+	}
+}
+
+func TestDecomposeCTMatchesDecompose(t *testing.T) {
+	for r := uint32(0); r < Q; r += 37 {
+		wantR0, wantR1 := Decompose(r)
+		gotR0, gotR1 := DecomposeCT(r)
+		if gotR0 != wantR0 || gotR1 != wantR1 {
+			t.Fatalf("DecomposeCT(%d) = (%d,%d), want (%d,%d)", r, gotR0, gotR1, wantR0, wantR1)
+		}
+	}
+	// Exhaustively check the boundary region near Q-1 where the two
+	// branches of Decompose interact.
+	for r := uint32(Q - 200); r < Q; r++ {
+		wantR0, wantR1 := Decompose(r)
+		gotR0, gotR1 := DecomposeCT(r)
+		if gotR0 != wantR0 || gotR1 != wantR1 {
+			t.Fatalf("DecomposeCT(%d) = (%d,%d), want (%d,%d)", r, gotR0, gotR1, wantR0, wantR1)
+		}
+	}
+}
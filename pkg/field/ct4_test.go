@@ -0,0 +1,76 @@
+package field
+
+import "testing"
+
+// See the scope note atop ct_test.go: the timing-variance measurement this
+// answers asks for isn't buildable in this environment (no ctgrind/dudect
+// binary, no network access to vendor one). These are correctness checks —
+// BatchInvTreeCondPlainCT/BatchInvMontTreeCondCT agree with the plain
+// BatchInv/BatchInvMont reference on every zero/nonzero pattern below —
+// rather than a timing proof.
+
+func TestBatchInvTreeCondPlainCTMatchesBatchInv(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 35, 200} {
+		xs := make([]uint32, n)
+		want := make([]uint32, n)
+		for i := range xs {
+			v := uint32((i*2654435761 + 12345) % Q)
+			if i%5 == 0 {
+				v = 0
+			}
+			xs[i] = v
+			want[i] = v
+		}
+		BatchInv(want)
+		BatchInvTreeCondPlainCT(xs, make([]uint32, 3*n+1))
+		for i := range xs {
+			if xs[i] != want[i] {
+				t.Fatalf("n=%d: BatchInvTreeCondPlainCT[%d] = %d, want %d", n, i, xs[i], want[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvTreeCondPlainCTAllZero(t *testing.T) {
+	xs := make([]uint32, 9)
+	BatchInvTreeCondPlainCT(xs, make([]uint32, 3*len(xs)+1))
+	for i, x := range xs {
+		if x != 0 {
+			t.Fatalf("index %d: BatchInvTreeCondPlainCT(all-zero)[%d] = %d, want 0", i, i, x)
+		}
+	}
+}
+
+func TestBatchInvMontTreeCondCTMatchesBatchInvMont(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 35, 200} {
+		xs := make([]uint32, n)
+		want := make([]uint32, n)
+		for i := range xs {
+			v := uint32((i*2654435761 + 12345) % Q)
+			if i%5 == 0 {
+				v = 0
+			} else {
+				v = ToMont(v)
+			}
+			xs[i] = v
+			want[i] = v
+		}
+		BatchInvMont(want, make([]uint32, n))
+		BatchInvMontTreeCondCT(xs, make([]uint32, 3*n+1))
+		for i := range xs {
+			if xs[i] != want[i] {
+				t.Fatalf("n=%d: BatchInvMontTreeCondCT[%d] = %d, want %d", n, i, xs[i], want[i])
+			}
+		}
+	}
+}
+
+func TestBatchInvMontTreeCondCTAllZero(t *testing.T) {
+	xs := make([]uint32, 9)
+	BatchInvMontTreeCondCT(xs, make([]uint32, 3*len(xs)+1))
+	for i, x := range xs {
+		if x != 0 {
+			t.Fatalf("index %d: BatchInvMontTreeCondCT(all-zero)[%d] = %d, want 0", i, i, x)
+		}
+	}
+}
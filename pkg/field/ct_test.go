@@ -0,0 +1,57 @@
+package field
+
+import "testing"
+
+// HONEST SCOPE NOTE: the request this answers asks for a dit/ctgrind-style
+// taint-tracking harness (e.g. via github.com/ericlagergren/subtle) that
+// fails the build on any secret-dependent branch or memory access. That
+// requires either a Valgrind/ctgrind binary or a third-party module, neither
+// of which is available in this environment (no network access to vendor
+// modules, no ctgrind install). What follows is a correctness check that
+// InvCT/InvMontCT agree with Inv/InvMont on every input class (in
+// particular a == 0, the one case where their control flow used to
+// diverge) — it can't prove the absence of a timing leak, only that
+// removing the early-return branch didn't change the result.
+
+func TestInvCTMatchesInv(t *testing.T) {
+	if got, want := InvCT(0), Inv(0); got != want {
+		t.Fatalf("InvCT(0) = %d, want %d", got, want)
+	}
+	for a := uint32(1); a < 2000; a++ {
+		if got, want := InvCT(a), Inv(a); got != want {
+			t.Fatalf("InvCT(%d) = %d, want %d", a, got, want)
+		}
+	}
+	// A handful of values near Q.
+	for _, a := range []uint32{Q - 1, Q - 2, Q / 2, Q/2 + 1} {
+		if got, want := InvCT(a), Inv(a); got != want {
+			t.Fatalf("InvCT(%d) = %d, want %d", a, got, want)
+		}
+	}
+}
+
+func TestInvMontCTMatchesInvMont(t *testing.T) {
+	if got, want := InvMontCT(0), InvMont(0); got != want {
+		t.Fatalf("InvMontCT(0) = %d, want %d", got, want)
+	}
+	for a := uint32(1); a < 2000; a++ {
+		aM := ToMont(a)
+		if got, want := InvMontCT(aM), InvMont(aM); got != want {
+			t.Fatalf("InvMontCT(ToMont(%d)) = %d, want %d", a, got, want)
+		}
+	}
+}
+
+// TestBatchInvRootInversionHandlesAllZero exercises the root-inversion
+// change in BatchInv directly: an all-zero input must stay all-zero rather
+// than panicking or producing garbage now that the root inversion always
+// runs the full chain instead of short-circuiting on zero.
+func TestBatchInvRootInversionHandlesAllZero(t *testing.T) {
+	xs := make([]uint32, 5)
+	BatchInv(xs)
+	for i, x := range xs {
+		if x != 0 {
+			t.Fatalf("index %d: BatchInv(all-zero)[%d] = %d, want 0", i, i, x)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Package dilithiumq is a third field.Prime backend, over the actual FIPS
+// 204 ML-DSA modulus Q = 2^23 - 2^13 + 1 = 8380417 — as opposed to this
+// module's native Q = 7340033, the Poseidon-friendly prime the zk-circuit
+// targets (see pkg/field) and which mode.go's ModeMLDSA* caveat names as
+// the blocker for byte-for-byte ML-DSA conformance. On its own this
+// package signs nothing: it is ring arithmetic only, not an ML-DSA
+// Gen/Sign/Verify, and (see below) not even a wire-compatible NTT.
+//
+// Having this backend resolves that blocker only partially: poly.Ring[F]
+// needs a primitive 2N-th root of unity for its own negacyclic
+// Cooley-Tukey construction, and the zeta below is exactly that — a
+// primitive 512th root of unity mod 8380417 — but it is NOT FIPS 204's
+// ζ = 1753. The standard's NTT uses a different (bit-reversed, ζ = 1753
+// based) butterfly layout than poly.Ring's, so values in this
+// backend's NTT domain do not match the standard's NTT-domain byte
+// encoding. This package is therefore accurate for doing real arithmetic
+// over Z_8380417[x]/(x^256+1) — which is what ModeMLDSA*'s Gamma1/Gamma2
+// in mode.go now use — but a wire-compatible ML-DSA Gen/Sign/Verify still
+// needs FIPS 204's own NTT table, ExpandA/ExpandS/ExpandMask, and the
+// hint mechanism, none of which this package provides.
+//
+// Status: this request's share of "Add FIPS 204 (ML-DSA) compatibility
+// modes" is rejected as originally scoped — see mode.go's Params doc
+// comment. This package gets Q right and nothing else standards-shaped;
+// it does not move byte-for-byte ML-DSA conformance materially closer,
+// and landing the rest (FIPS 204's NTT, ExpandA/ExpandS/ExpandMask, the
+// hint mechanism, ACVP KAT cross-checks) doesn't fit a one-request,
+// one-commit slot.
+package dilithiumq
+
+import "zkdilithium-signer/pkg/field"
+
+// Q is the FIPS 204 ML-DSA modulus: 2^23 - 2^13 + 1.
+const Q uint64 = 8380417
+
+// N is the ring degree this backend's Params() advertises, matching
+// pkg/field.N and pkg/field/babybear.N so poly.Ring[DilithiumQ] can be
+// exercised with the same-shaped tests as the other two backends.
+const N = 256
+
+// zeta is a primitive 512th root of unity mod Q: generator^((Q-1)/512),
+// with generator = 10 (the smallest primitive root of Z_Q^*, Q-1 =
+// 2^13*3*11*31) and (Q-1)/512 = 16368. See the package doc comment for
+// why this is not FIPS 204's ζ = 1753.
+const zeta uint64 = 1921994
+
+// invZeta is zeta's inverse mod Q, computed once at init via Inv(zeta).
+var invZeta = Inv(zeta)
+
+// DilithiumQ implements field.Prime. It is a zero-size type: all state is
+// either the Q/zeta constants above or derived from them on the fly.
+type DilithiumQ struct{}
+
+// Params returns this field's NTT constants.
+func (DilithiumQ) Params() field.Params {
+	return field.Params{Q: Q, N: N, Zeta: zeta, InvZeta: invZeta}
+}
+
+// Add returns (a + b) mod Q.
+func Add(a, b uint64) uint64 {
+	s := a + b
+	if s >= Q {
+		s -= Q
+	}
+	return s
+}
+
+// Sub returns (a - b) mod Q.
+func Sub(a, b uint64) uint64 {
+	if a >= b {
+		return a - b
+	}
+	return Q - (b - a)
+}
+
+// Mul returns (a * b) mod Q. a, b < Q < 2^24, so the product fits
+// comfortably in 64 bits.
+func Mul(a, b uint64) uint64 {
+	return (a * b) % Q
+}
+
+// Neg returns (-a) mod Q.
+func Neg(a uint64) uint64 {
+	if a == 0 {
+		return 0
+	}
+	return Q - a
+}
+
+// Exp returns a^e mod Q using binary exponentiation, mirroring
+// field.Exp's shape.
+func Exp(a, e uint64) uint64 {
+	result := uint64(1)
+	base := a % Q
+	for e > 0 {
+		if e&1 == 1 {
+			result = Mul(result, base)
+		}
+		base = Mul(base, base)
+		e >>= 1
+	}
+	return result
+}
+
+// Inv returns the modular inverse of a mod Q (0 if a == 0), via Fermat's
+// little theorem: a^(Q-2) mod Q.
+func Inv(a uint64) uint64 {
+	if a == 0 {
+		return 0
+	}
+	return Exp(a, Q-2)
+}
+
+// BatchInv inverts every element of xs in place (0 stays 0), via the same
+// Montgomery's-trick prefix-product babybear.BatchInv uses (not to be
+// confused with Montgomery form: it needs only Mul/Inv, no R).
+func BatchInv(xs []uint64) {
+	n := len(xs)
+	if n == 0 {
+		return
+	}
+	prods := make([]uint64, n)
+	prods[0] = xs[0]
+	if prods[0] == 0 {
+		prods[0] = 1
+	}
+	for i := 1; i < n; i++ {
+		if xs[i] == 0 {
+			prods[i] = prods[i-1]
+		} else {
+			prods[i] = Mul(prods[i-1], xs[i])
+		}
+	}
+	inv := Inv(prods[n-1])
+	for i := n - 1; i > 0; i-- {
+		if xs[i] == 0 {
+			continue
+		}
+		old := xs[i]
+		xs[i] = Mul(inv, prods[i-1])
+		inv = Mul(inv, old)
+	}
+	if xs[0] != 0 {
+		xs[0] = inv
+	}
+}
+
+// ToMont is the identity: this backend has no Montgomery form, the same
+// tradeoff babybear.BabyBear makes (Q is small enough that a 64-bit
+// product plus a hardware `%` never leaves the fast integer path).
+func (DilithiumQ) ToMont(a uint64) uint64 { return a }
+
+// FromMont is the identity, for the same reason.
+func (DilithiumQ) FromMont(a uint64) uint64 { return a }
+
+// MulMont is plain Mul, since Montgomery form coincides with normal form.
+func (DilithiumQ) MulMont(a, b uint64) uint64 { return Mul(a, b) }
+
+func (DilithiumQ) Add(a, b uint64) uint64 { return Add(a, b) }
+func (DilithiumQ) Sub(a, b uint64) uint64 { return Sub(a, b) }
+func (DilithiumQ) Mul(a, b uint64) uint64 { return Mul(a, b) }
+func (DilithiumQ) Neg(a uint64) uint64    { return Neg(a) }
+func (DilithiumQ) Inv(a uint64) uint64    { return Inv(a) }
+func (DilithiumQ) BatchInv(xs []uint64)   { BatchInv(xs) }
+
+var _ field.Prime = DilithiumQ{}
@@ -0,0 +1,80 @@
+// Package fiat provides an independent, math/big-based reference
+// implementation of Z_Q arithmetic (Q = 7340033) to use as a differential
+// oracle against pkg/field's hand-written Montgomery/Barrett routines.
+//
+// HONEST SCOPE NOTE: the request this package answers asks for a vendored
+// fiat-crypto-generated (machine-verified) backend, the way curve25519-voi
+// wraps fiat's Go output. Actually generating and vendoring such a backend
+// requires the fiat-crypto Coq/OCaml toolchain, which is not available in
+// this environment. This package instead provides a deliberately
+// simple, bit-trick-free reference (plain math/big reduction) that serves
+// the same role as a differential oracle: every hand-optimized routine in
+// pkg/field can be fuzzed against it to catch carry/reduction bugs that
+// hand review misses. Swapping in real fiat-crypto output later should be
+// a drop-in replacement for the functions below.
+package fiat
+
+import "math/big"
+
+// Q is the field modulus, mirrored from pkg/field to keep this package
+// import-independent (so it can be vendored/replaced without a cycle).
+const Q = 7340033
+
+var bigQ = big.NewInt(Q)
+
+// Add returns (a + b) mod Q using math/big.
+func Add(a, b uint32) uint32 {
+	r := new(big.Int).Add(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	r.Mod(r, bigQ)
+	return uint32(r.Uint64())
+}
+
+// Sub returns (a - b) mod Q using math/big.
+func Sub(a, b uint32) uint32 {
+	r := new(big.Int).Sub(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	r.Mod(r, bigQ)
+	return uint32(r.Uint64())
+}
+
+// Mul returns (a * b) mod Q using math/big.
+func Mul(a, b uint32) uint32 {
+	r := new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b)))
+	r.Mod(r, bigQ)
+	return uint32(r.Uint64())
+}
+
+// Neg returns (-a) mod Q using math/big.
+func Neg(a uint32) uint32 {
+	r := new(big.Int).Neg(big.NewInt(int64(a)))
+	r.Mod(r, bigQ)
+	return uint32(r.Uint64())
+}
+
+// Inv returns the modular inverse of a mod Q (0 if a == 0), via
+// big.Int.ModInverse rather than any addition-chain trick.
+func Inv(a uint32) uint32 {
+	if a == 0 {
+		return 0
+	}
+	r := new(big.Int).ModInverse(big.NewInt(int64(a)), bigQ)
+	if r == nil {
+		return 0
+	}
+	return uint32(r.Uint64())
+}
+
+// Exp returns a^e mod Q via big.Int.Exp.
+func Exp(a, e uint32) uint32 {
+	r := new(big.Int).Exp(big.NewInt(int64(a)), big.NewInt(int64(e)), bigQ)
+	return uint32(r.Uint64())
+}
+
+// BatchInv computes the modular inverse of each element, 0 stays 0. It is
+// the naive O(n) one-inversion-per-element form, used only as an oracle.
+func BatchInv(xs []uint32) []uint32 {
+	out := make([]uint32, len(xs))
+	for i, x := range xs {
+		out[i] = Inv(x)
+	}
+	return out
+}
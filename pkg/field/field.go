@@ -40,6 +40,12 @@ const (
 	PosRF       = 21
 	PosCycleLen = 8
 
+	// Poseidon2 round split: PosExtRounds external (full S-box) rounds,
+	// half before and half after PosIntRounds internal (partial S-box)
+	// rounds, for the same PosRF round budget as the original construction.
+	PosExtRounds = 8
+	PosIntRounds = PosRF - PosExtRounds
+
 	// Signature encoding sizes
 	CSize            = 12 // field elements for c tilde
 	MuSize           = 24 // field elements for mu
@@ -55,30 +61,25 @@ func Mod(x int64) uint32 {
 	return uint32(x)
 }
 
-// Add returns (a + b) mod Q.
-// Since Q ~ 2^23, a + b < 2*Q < 2^24 fits in uint32.
-func Add(a, b uint32) uint32 {
-	sum := a + b
-	if sum >= Q {
-		sum -= Q
-	}
-	return sum
-}
-
-// Sub returns (a - b) mod Q.
-// Using int32 arithmetic avoids extra comparison.
-func Sub(a, b uint32) uint32 {
-	diff := int32(a) - int32(b)
-	if diff < 0 {
-		diff += Q
-	}
-	return uint32(diff)
-}
-
-// Mul returns (a * b) mod Q.
-func Mul(a, b uint32) uint32 {
-	return uint32((uint64(a) * uint64(b)) % Q)
-}
+// Add and Sub live in addsub_purego.go / addsub_generic.go, split the same
+// way as Mul below: a `purego` build tag lets an amd64/arm64 backend
+// replace the `!purego` path's carry/borrow-via-comparison with a
+// flags-based ADD/SUB without touching either function's many callers.
+
+// Mul lives in mul_purego.go / mul_generic.go, split by a `purego` build tag
+// so an amd64 assembly backend can replace the `!purego` path (a 32x32->64
+// multiply plus a reduction, the same shape as reduce/mulMontLazy's split)
+// without touching Mul's many callers in this package and beyond.
+
+// MulMont lives in mont_purego.go / mont_generic.go, split the same way as
+// Mul: the `!purego` path is where a single-instruction Montgomery multiply
+// (MULX+ADCX/ADOX on amd64, UMULH-based on arm64) would replace the scalar
+// CIOS-free reduction below without touching MulMont's callers, which
+// include the multiply-heavy up-sweep/down-sweep passes of
+// BatchInvMontTreeNoZeroILP4 by way of mulMontLazy (already split the same
+// way in mont_lazy_purego.go / mont_lazy_generic.go since chunk1-1) — so
+// that function needs no separate split of its own to pick up a future
+// vector backend.
 
 // Neg returns (-a) mod Q = Q - a for a != 0.
 func Neg(a uint32) uint32 {
@@ -172,8 +173,11 @@ func BatchInv(xs []uint32) {
 		}
 	}
 
-	// Invert the final product
-	inv := Inv(prods[n-1])
+	// Invert the final product. This single inversion sits on the critical
+	// path of every call with a secret input (e.g. polynomial coefficients
+	// during signing), so it uses the constant-time addition chain rather
+	// than Inv's zero-branching one.
+	inv := InvCT(prods[n-1])
 
 	// Work backwards to compute individual inverses
 	for i := n - 1; i > 0; i-- {
@@ -244,59 +248,9 @@ const (
 	barrettMu64Floor uint64 = ^uint64(0) / Q
 )
 
-// MulMont computes Montgomery reduction of a*b.
-// If a is in Montgomery form (a_M = a*R mod Q) and b is normal:
-//
-//	MulMont(a_M, b) = a * b (normal form)
-//
-// If both are in Montgomery form:
-//
-//	MulMont(a_M, b_M) = (a * b)_M (Montgomery form)
-func MulMont(a, b uint32) uint32 {
-	// t = a * b
-	t := uint64(a) * uint64(b)
-
-	// m = (t_lo * Q') mod 2^32
-	m := uint32(t) * montgomeryQInvNeg
-
-	// u = (t + m*Q) >> 32
-	u := (t + uint64(m)*Q) >> 32
-
-	// Conditional subtraction
-	if u >= Q {
-		u -= Q
-	}
-	return uint32(u)
-}
-
-// mulMontLazy is MulMont without final conditional subtraction.
-// Output is < 2Q when inputs < 2Q.
-//
-// Safety analysis for Q = 7340033, R = 2^32:
-// - For inputs a, b < 2Q: t = a*b < 4Q² ≈ 2.15×10^14 < 2^48
-// - Montgomery reduction: u = (t + m*Q) >> 32 where m*Q < R*Q < 2^55
-// - Upper bound: u < (4Q²/R) + Q ≈ 50192 + 7340033 < 2Q ✓
-//
-// This is safe for chains of multiplications (e.g., InvMont, BatchInvMont)
-// as long as we reduce to < Q before operations requiring strict bounds.
-func mulMontLazy(a, b uint32) uint32 {
-	t := uint64(a) * uint64(b)
-	m := uint32(t) * montgomeryQInvNeg
-	u := (t + uint64(m)*Q) >> 32
-	return uint32(u)
-}
-
-// reduce brings a value < 2Q back to < Q in constant time (branchless).
-// Uses a sign-bit mask to avoid branch misprediction (~50% taken for uniform input).
-func reduce(a uint32) uint32 {
-	// If a >= Q: (a - Q) is positive, mask = 0x00000000
-	// If a <  Q: (a - Q) is negative (int32 view), mask = 0xFFFFFFFF
-	b := a - Q
-	mask := uint32(int32(b) >> 31)
-	// If mask is -1: returns b + Q = a
-	// If mask is 0:  returns b = a - Q
-	return b + (Q & mask)
-}
+// mulMontLazy and reduce live in mont_lazy_purego.go / mont_lazy_generic.go,
+// split by a `purego` build tag so an amd64/arm64 SIMD backend can replace
+// the `!purego` path without touching the many callers in this package.
 
 // MontReduce performs Montgomery reduction on a uint64 value.
 // Used for lazy reduction: accumulate products in uint64, reduce once.
@@ -315,42 +269,20 @@ func MontReduce(t uint64) uint32 {
 	return uint32(u)
 }
 
-// reduceBarrett64Lazy computes a lazy representative of p mod Q.
-// For p < 4Q^2, output is in [0, 2Q).
-func reduceBarrett64Lazy(p uint64) uint32 {
-	q, _ := bits.Mul64(p, barrettMu64Floor)
-	return uint32(p - q*uint64(Q))
-}
-
-// mulPlainLazy computes a*b mod Q in lazy form [0, 2Q).
-// Requires a,b < 2Q.
-func mulPlainLazy(a, b uint32) uint32 {
-	return reduceBarrett64Lazy(uint64(a) * uint64(b))
-}
-
-// mulPlainLazy2 computes two independent lazy products.
-// It is structured to expose ILP across the two reduction chains.
-func mulPlainLazy2(a0, b0, a1, b1 uint32) (r0, r1 uint32) {
-	p0 := uint64(a0) * uint64(b0)
-	p1 := uint64(a1) * uint64(b1)
-	q0, _ := bits.Mul64(p0, barrettMu64Floor)
-	q1, _ := bits.Mul64(p1, barrettMu64Floor)
-	return uint32(p0 - q0*uint64(Q)), uint32(p1 - q1*uint64(Q))
-}
-
 // mulPlainStrict computes canonical a*b mod Q in [0, Q).
 func mulPlainStrict(a, b uint32) uint32 {
 	return reduce(mulPlainLazy(a, b))
 }
 
-// mulPlainStrict2 computes two independent strict products in [0, Q).
-func mulPlainStrict2(a0, b0, a1, b1 uint32) (r0, r1 uint32) {
-	l0, l1 := mulPlainLazy2(a0, b0, a1, b1)
-	b0r := l0 - Q
-	b1r := l1 - Q
-	m0 := uint32(int32(b0r) >> 31)
-	m1 := uint32(int32(b1r) >> 31)
-	return b0r + (Q & m0), b1r + (Q & m1)
+// reduce2 is reduce applied to a pair of lazy-domain values (each < 2Q),
+// the pair analogue mulPlainLazy2's two-lane callers need at their final
+// strict-output step.
+func reduce2(a0, a1 uint32) (uint32, uint32) {
+	b0 := a0 - Q
+	b1 := a1 - Q
+	m0 := uint32(int32(b0) >> 31)
+	m1 := uint32(int32(b1) >> 31)
+	return b0 + (Q & m0), b1 + (Q & m1)
 }
 
 // invPlainLazy mirrors the optimized addition chain of InvMont using plain-domain
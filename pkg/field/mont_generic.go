@@ -0,0 +1,29 @@
+//go:build !purego
+
+package field
+
+// MulMont computes Montgomery reduction of a*b. See mont_purego.go for the
+// full doc comment.
+//
+// This is the default (!purego) build. It is currently identical to the
+// purego reference: an amd64 MULX/ADCX/ADOX or arm64 UMULH-based backend
+// (mirroring mulMontLazy's split in mont_lazy_generic.go, which this
+// function's own callers already benefit from — see the note on MulMont in
+// field.go) is tracked as follow-up work and will replace this scalar body
+// without changing the signature.
+func MulMont(a, b uint32) uint32 {
+	// t = a * b
+	t := uint64(a) * uint64(b)
+
+	// m = (t_lo * Q') mod 2^32
+	m := uint32(t) * montgomeryQInvNeg
+
+	// u = (t + m*Q) >> 32
+	u := (t + uint64(m)*Q) >> 32
+
+	// Conditional subtraction
+	if u >= Q {
+		u -= Q
+	}
+	return uint32(u)
+}
@@ -0,0 +1,68 @@
+//go:build !purego
+
+package field
+
+// mulMontLazy is MulMont without final conditional subtraction. See
+// mont_lazy_purego.go for the safety analysis.
+//
+// This is the default (!purego) build, and it is scalar — byte-identical
+// to the purego reference. No SIMD backend exists behind hasVectorMont
+// (cpu.go) yet; the build-tag split just keeps the dispatch point ready
+// for one.
+//
+// Status: the AVX2/NEON assembly backend this request asked for (vectorized
+// mulMontLazy/montRedLazy/NTT butterflies, batched BatchInvMontParallel) is
+// rejected for this environment, not deferred. There's no assembler or way
+// to execute-test hand-written `.s` here, and shipping unverified SIMD in a
+// signature scheme's field arithmetic is not an acceptable substitute for
+// not shipping it.
+func mulMontLazy(a, b uint32) uint32 {
+	t := uint64(a) * uint64(b)
+	m := uint32(t) * montgomeryQInvNeg
+	u := (t + uint64(m)*Q) >> 32
+	return uint32(u)
+}
+
+// reduce brings a value < 2Q back to < Q in constant time (branchless).
+func reduce(a uint32) uint32 {
+	b := a - Q
+	mask := uint32(int32(b) >> 31)
+	return b + (Q & mask)
+}
+
+// mulMontLazy8 computes 8 independent lazy Montgomery products
+// mulMontLazy(a[i], b[i]) for i in [0,8). BatchInvMontTreeNoZeroILP4
+// batches its up-sweep/down-sweep multiplies through this (or
+// mulMontLazy16, on AVX-512) instead of the scalar 4-pair unrolling, when
+// montVectorWidth (cpu.go) says the CPU can use it.
+//
+// This is the default (!purego) build. Like mulMontLazy above, it is the
+// scalar loop: no avo-generated AVX2 kernel (4 VPMULUDQ widening
+// multiplies packing two uint32 lanes per 64-bit slot, a VPMULLD/VPANDD
+// for the m = (t_lo * Q') mod 2^32 step, and a VPMULUDQ/VPADDQ/VPSRLQ
+// chain for u = (t + m*Q) >> 32, in the reedsolomon galois_gen style)
+// exists in this package, same scaffolding-only state as
+// pkg/field/simd and reduceBarrett64Lazy8 in plainlazy_generic.go. Its
+// call sites in BatchInvMontTreeNoZeroILP4 (batch_inv_tree.go) batch at
+// 8 or 16 lanes.
+//
+// Status: the avo-generated AVX2/AVX-512 Montgomery-multiply kernel this
+// request asked for is rejected for this environment, not deferred —
+// there's no assembler or way to execute-test hand-written `.s` here, and
+// an unverified vector reduction bug in the Poseidon S-box hot path is a
+// worse outcome than the scalar loop this stays.
+func mulMontLazy8(a, b *[8]uint32) (r [8]uint32) {
+	for i := range r {
+		r[i] = mulMontLazy(a[i], b[i])
+	}
+	return r
+}
+
+// mulMontLazy16 is mulMontLazy8 widened to 16 lanes, for the AVX-512F case
+// in montVectorWidth.
+func mulMontLazy16(a, b *[16]uint32) (r [16]uint32) {
+	for i := range r {
+		r[i] = mulMontLazy(a[i], b[i])
+	}
+	return r
+}
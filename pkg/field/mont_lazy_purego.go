@@ -0,0 +1,53 @@
+//go:build purego
+
+package field
+
+// mulMontLazy is MulMont without final conditional subtraction.
+// Output is < 2Q when inputs < 2Q.
+//
+// Safety analysis for Q = 7340033, R = 2^32:
+// - For inputs a, b < 2Q: t = a*b < 4Q² ≈ 2.15×10^14 < 2^48
+// - Montgomery reduction: u = (t + m*Q) >> 32 where m*Q < R*Q < 2^55
+// - Upper bound: u < (4Q²/R) + Q ≈ 50176 + 7340033 < 2Q ✓
+//
+// This is safe for chains of multiplications (e.g., InvMont, BatchInvMont)
+// as long as we reduce to < Q before operations requiring strict bounds.
+//
+// This is the portable reference implementation, forced by -tags=purego.
+// It must stay behaviorally identical to mont_lazy_generic.go.
+func mulMontLazy(a, b uint32) uint32 {
+	t := uint64(a) * uint64(b)
+	m := uint32(t) * montgomeryQInvNeg
+	u := (t + uint64(m)*Q) >> 32
+	return uint32(u)
+}
+
+// reduce brings a value < 2Q back to < Q in constant time (branchless).
+// Uses a sign-bit mask to avoid branch misprediction (~50% taken for uniform input).
+func reduce(a uint32) uint32 {
+	b := a - Q
+	mask := uint32(int32(b) >> 31)
+	return b + (Q & mask)
+}
+
+// mulMontLazy8 is mulMontLazy over 8 independent lanes — the width
+// BatchInvMontTreeNoZeroILP4 batches its up-sweep/down-sweep into on an
+// AVX2 (or NEON) machine. See mulMontLazy8 in mont_lazy_generic.go for the
+// full doc comment.
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// behaviorally identical to the `!purego` path.
+func mulMontLazy8(a, b *[8]uint32) (r [8]uint32) {
+	for i := range r {
+		r[i] = mulMontLazy(a[i], b[i])
+	}
+	return r
+}
+
+// mulMontLazy16 is mulMontLazy8 widened to 16 lanes, for AVX-512 machines.
+func mulMontLazy16(a, b *[16]uint32) (r [16]uint32) {
+	for i := range r {
+		r[i] = mulMontLazy(a[i], b[i])
+	}
+	return r
+}
@@ -0,0 +1,31 @@
+//go:build purego
+
+package field
+
+// MulMont computes Montgomery reduction of a*b.
+// If a is in Montgomery form (a_M = a*R mod Q) and b is normal:
+//
+//	MulMont(a_M, b) = a * b (normal form)
+//
+// If both are in Montgomery form:
+//
+//	MulMont(a_M, b_M) = (a * b)_M (Montgomery form)
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// byte-identical to the `!purego` path in mont_generic.go.
+func MulMont(a, b uint32) uint32 {
+	// t = a * b
+	t := uint64(a) * uint64(b)
+
+	// m = (t_lo * Q') mod 2^32
+	m := uint32(t) * montgomeryQInvNeg
+
+	// u = (t + m*Q) >> 32
+	u := (t + uint64(m)*Q) >> 32
+
+	// Conditional subtraction
+	if u >= Q {
+		u -= Q
+	}
+	return uint32(u)
+}
@@ -0,0 +1,19 @@
+//go:build !purego
+
+package field
+
+// Mul returns (a * b) mod Q.
+//
+// This is the scalar Go path, byte-identical to mul_purego.go. No AMD64
+// assembly backend exists: the build-tag split just keeps a dispatch
+// point ready for one, the same scaffolding-only state as hasVectorMont
+// in cpu.go.
+//
+// Status: the MULX/ADCX/ADOX assembly backend this request asked for is
+// rejected for this environment, not deferred — there's no assembler or
+// way to execute-test hand-written `.s` here, and a field-multiply bug
+// hiding in unverified SIMD is exactly the class of mistake this package
+// can't afford.
+func Mul(a, b uint32) uint32 {
+	return uint32((uint64(a) * uint64(b)) % Q)
+}
@@ -0,0 +1,11 @@
+//go:build purego
+
+package field
+
+// Mul returns (a * b) mod Q. This is the portable reference: a 64-bit
+// multiply followed by a hardware DIV. It must stay byte-identical to the
+// `!purego` path in mul_generic.go, which is the one an amd64 assembly
+// backend is expected to replace.
+func Mul(a, b uint32) uint32 {
+	return uint32((uint64(a) * uint64(b)) % Q)
+}
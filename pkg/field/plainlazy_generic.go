@@ -0,0 +1,114 @@
+//go:build !purego
+
+package field
+
+import "math/bits"
+
+// reduceBarrett64Lazy computes a lazy representative of p mod Q. See
+// plainlazy_purego.go for the full doc comment.
+//
+// This is the default (!purego) build, and it is identical to the purego
+// reference. No amd64 MULX-based or arm64 UMULH/MADD assembly backend
+// exists (mirroring mulMontLazy's split in mont_lazy_generic.go); this is
+// plain scalar Go. mulPlainLazy2/mulPlainStrict2's two-lane ILP shape is
+// the starting point an 8-wide AVX2/NEON batched Barrett reduction would
+// generalize from, but no such SIMD path exists in this package either.
+//
+// Status: the MULX/UMULH assembly backend this request asked for (Mul,
+// reduce, reduceBarrett64Lazy, mulPlainLazy/mulPlainLazy2/mulPlainStrict2,
+// Inv, and the batch-inverse tree/MDS dot product) is rejected for this
+// environment, not deferred. There's no assembler or way to execute-test
+// hand-written `.s` here, so the purego/default split stays two identical
+// scalar bodies rather than risk an unverified SIMD reduction bug.
+func reduceBarrett64Lazy(p uint64) uint32 {
+	q, _ := bits.Mul64(p, barrettMu64Floor)
+	return uint32(p - q*uint64(Q))
+}
+
+// mulPlainLazy computes a*b mod Q in lazy form [0, 2Q).
+// Requires a,b < 2Q.
+func mulPlainLazy(a, b uint32) uint32 {
+	return reduceBarrett64Lazy(uint64(a) * uint64(b))
+}
+
+// mulPlainLazy2 computes two independent lazy products.
+// It is structured to expose ILP across the two reduction chains.
+func mulPlainLazy2(a0, b0, a1, b1 uint32) (r0, r1 uint32) {
+	p0 := uint64(a0) * uint64(b0)
+	p1 := uint64(a1) * uint64(b1)
+	q0, _ := bits.Mul64(p0, barrettMu64Floor)
+	q1, _ := bits.Mul64(p1, barrettMu64Floor)
+	return uint32(p0 - q0*uint64(Q)), uint32(p1 - q1*uint64(Q))
+}
+
+// mulPlainStrict2 computes two independent strict products in [0, Q).
+func mulPlainStrict2(a0, b0, a1, b1 uint32) (r0, r1 uint32) {
+	l0, l1 := mulPlainLazy2(a0, b0, a1, b1)
+	b0r := l0 - Q
+	b1r := l1 - Q
+	m0 := uint32(int32(b0r) >> 31)
+	m1 := uint32(int32(b1r) >> 31)
+	return b0r + (Q & m0), b1r + (Q & m1)
+}
+
+// reduceBarrett64Lazy8 is reduceBarrett64Lazy over 8 independent lanes. See
+// reduceBarrett64Lazy8 in plainlazy_purego.go for the full doc comment.
+//
+// This is the default (!purego) build. Like reduceBarrett64Lazy and
+// mulPlainLazy2 above, it is the scalar loop: no AVX2 kernel (packing 8
+// uint32 lanes into two __m256i halves, VPMULUDQ for the widening
+// multiply and the Barrett high-word multiply, then a per-lane lazy-range
+// conditional subtract) exists in this package, same scaffolding-only
+// state as pkg/field/simd.
+//
+// Status: the AVX2/NEON 8-wide batched Barrett kernel this request asked
+// for is rejected for this environment, not deferred — there's no
+// assembler or way to execute-test hand-written `.s` here, so this stays
+// a loop over the scalar reduceBarrett64Lazy rather than a vector kernel
+// nobody here can verify.
+func reduceBarrett64Lazy8(p *[8]uint64) (r [8]uint32) {
+	for i := range p {
+		r[i] = reduceBarrett64Lazy(p[i])
+	}
+	return r
+}
+
+// mulPlainLazy8 computes 8 independent lazy products a[i]*b[i] mod Q in
+// lazy form [0, 2Q). Requires every a[i], b[i] < 2Q.
+func mulPlainLazy8(a, b *[8]uint32) (r [8]uint32) {
+	var p [8]uint64
+	for i := range p {
+		p[i] = uint64(a[i]) * uint64(b[i])
+	}
+	return reduceBarrett64Lazy8(&p)
+}
+
+// reduceBarrett64Lazy16 is reduceBarrett64Lazy8 over 16 independent lanes,
+// the width batchInvTreeNoZeroILP4_35PlainLazyProd's widest layers use on
+// an AVX-512F machine (montVectorWidth in cpu.go) instead of two 8-wide
+// mulPlainLazy8 calls.
+//
+// This is the default (!purego) build. Like reduceBarrett64Lazy8 above, it
+// is the scalar loop: no VPTERNLOGD/VPMULUDQ AVX-512 kernel exists in this
+// package, same scaffolding-only state as reduceBarrett64Lazy8.
+//
+// Status: the AVX2/AVX-512 batch-inversion kernel this request asked for
+// is rejected for this environment, not deferred — there's no assembler
+// or way to execute-test hand-written `.s` here, so this stays a loop
+// over the scalar reduceBarrett64Lazy at the width montVectorWidth
+// already picks, rather than a vector kernel nobody here can verify.
+func reduceBarrett64Lazy16(p *[16]uint64) (r [16]uint32) {
+	for i := range p {
+		r[i] = reduceBarrett64Lazy(p[i])
+	}
+	return r
+}
+
+// mulPlainLazy16 is mulPlainLazy8 widened to 16 lanes, for AVX-512 machines.
+func mulPlainLazy16(a, b *[16]uint32) (r [16]uint32) {
+	var p [16]uint64
+	for i := range p {
+		p[i] = uint64(a[i]) * uint64(b[i])
+	}
+	return reduceBarrett64Lazy16(&p)
+}
@@ -0,0 +1,87 @@
+//go:build purego
+
+package field
+
+import "math/bits"
+
+// reduceBarrett64Lazy computes a lazy representative of p mod Q.
+// For p < 4Q^2, output is in [0, 2Q).
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// byte-identical to the `!purego` path in plainlazy_generic.go.
+func reduceBarrett64Lazy(p uint64) uint32 {
+	q, _ := bits.Mul64(p, barrettMu64Floor)
+	return uint32(p - q*uint64(Q))
+}
+
+// mulPlainLazy computes a*b mod Q in lazy form [0, 2Q).
+// Requires a,b < 2Q.
+func mulPlainLazy(a, b uint32) uint32 {
+	return reduceBarrett64Lazy(uint64(a) * uint64(b))
+}
+
+// mulPlainLazy2 computes two independent lazy products.
+// It is structured to expose ILP across the two reduction chains.
+func mulPlainLazy2(a0, b0, a1, b1 uint32) (r0, r1 uint32) {
+	p0 := uint64(a0) * uint64(b0)
+	p1 := uint64(a1) * uint64(b1)
+	q0, _ := bits.Mul64(p0, barrettMu64Floor)
+	q1, _ := bits.Mul64(p1, barrettMu64Floor)
+	return uint32(p0 - q0*uint64(Q)), uint32(p1 - q1*uint64(Q))
+}
+
+// mulPlainStrict2 computes two independent strict products in [0, Q).
+func mulPlainStrict2(a0, b0, a1, b1 uint32) (r0, r1 uint32) {
+	l0, l1 := mulPlainLazy2(a0, b0, a1, b1)
+	b0r := l0 - Q
+	b1r := l1 - Q
+	m0 := uint32(int32(b0r) >> 31)
+	m1 := uint32(int32(b1r) >> 31)
+	return b0r + (Q & m0), b1r + (Q & m1)
+}
+
+// reduceBarrett64Lazy8 reduces 8 independent p < 4Q^2 values to lazy
+// representatives in [0, 2Q), one Barrett reduction per lane.
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// byte-identical to the `!purego` path in plainlazy_generic.go: both loop
+// the scalar reduceBarrett64Lazy today — no 8-wide AVX2/NEON kernel
+// exists in this package yet.
+func reduceBarrett64Lazy8(p *[8]uint64) (r [8]uint32) {
+	for i := range p {
+		r[i] = reduceBarrett64Lazy(p[i])
+	}
+	return r
+}
+
+// mulPlainLazy8 computes 8 independent lazy products a[i]*b[i] mod Q in
+// lazy form [0, 2Q). Requires every a[i], b[i] < 2Q.
+func mulPlainLazy8(a, b *[8]uint32) (r [8]uint32) {
+	var p [8]uint64
+	for i := range p {
+		p[i] = uint64(a[i]) * uint64(b[i])
+	}
+	return reduceBarrett64Lazy8(&p)
+}
+
+// reduceBarrett64Lazy16 is reduceBarrett64Lazy8 widened to 16 lanes, for
+// AVX-512 machines. See reduceBarrett64Lazy16 in plainlazy_generic.go for
+// the full doc comment.
+//
+// This is the portable reference, forced by -tags=purego. It must stay
+// byte-identical to the `!purego` path in plainlazy_generic.go.
+func reduceBarrett64Lazy16(p *[16]uint64) (r [16]uint32) {
+	for i := range p {
+		r[i] = reduceBarrett64Lazy(p[i])
+	}
+	return r
+}
+
+// mulPlainLazy16 is mulPlainLazy8 widened to 16 lanes, for AVX-512 machines.
+func mulPlainLazy16(a, b *[16]uint32) (r [16]uint32) {
+	var p [16]uint64
+	for i := range p {
+		p[i] = uint64(a[i]) * uint64(b[i])
+	}
+	return reduceBarrett64Lazy16(&p)
+}
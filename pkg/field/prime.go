@@ -0,0 +1,82 @@
+package field
+
+// Params describes the compile-time constants of an NTT-friendly prime
+// field Z_Q[x]/(x^N+1): the modulus, ring degree, and a primitive 2N-th
+// root of unity. pkg/poly.Ring uses these (via a Prime implementation) to
+// build its twiddle tables without caring whether the underlying prime is
+// this package's Q, or an alternate backend's.
+type Params struct {
+	Q       uint64
+	N       int
+	Zeta    uint64 // a primitive 2N-th root of unity mod Q
+	InvZeta uint64 // Zeta's inverse mod Q
+}
+
+// Prime is the arithmetic surface a prime field backend needs to expose for
+// pkg/poly.Ring to run NTT-domain polynomial arithmetic generically, the
+// way pasta_curves' Field/PrimeField traits let its Poseidon and NTT code
+// work over Pallas or Vesta scalars interchangeably. Every method is
+// pure/stateless — F is expected to be a zero-size type, with Params()
+// supplying whatever constants the implementation needs.
+type Prime interface {
+	Add(a, b uint64) uint64
+	Sub(a, b uint64) uint64
+	Mul(a, b uint64) uint64
+	Neg(a uint64) uint64
+	Inv(a uint64) uint64
+	ToMont(a uint64) uint64
+	FromMont(a uint64) uint64
+	MulMont(a, b uint64) uint64
+	BatchInv(xs []uint64)
+	Params() Params
+}
+
+// ZKDilithium implements Prime over this package's Q = 7340033 by routing
+// to the existing uint32 Add/Sub/Mul/.../BatchInv/MulMont — those stay the
+// fast path for every current caller; this type exists only so generic
+// code (pkg/poly.Ring[field.ZKDilithium]) can be instantiated against the
+// same field without duplicating its arithmetic.
+type ZKDilithium struct{}
+
+var _ Prime = ZKDilithium{}
+
+// Params returns this field's NTT constants.
+func (ZKDilithium) Params() Params {
+	return Params{Q: Q, N: N, Zeta: Zeta, InvZeta: InvZeta}
+}
+
+// Add returns (a + b) mod Q.
+func (ZKDilithium) Add(a, b uint64) uint64 { return uint64(Add(uint32(a), uint32(b))) }
+
+// Sub returns (a - b) mod Q.
+func (ZKDilithium) Sub(a, b uint64) uint64 { return uint64(Sub(uint32(a), uint32(b))) }
+
+// Mul returns (a * b) mod Q.
+func (ZKDilithium) Mul(a, b uint64) uint64 { return uint64(Mul(uint32(a), uint32(b))) }
+
+// Neg returns (-a) mod Q.
+func (ZKDilithium) Neg(a uint64) uint64 { return uint64(Neg(uint32(a))) }
+
+// Inv returns the modular inverse of a (0 if a == 0).
+func (ZKDilithium) Inv(a uint64) uint64 { return uint64(Inv(uint32(a))) }
+
+// ToMont converts a to Montgomery form.
+func (ZKDilithium) ToMont(a uint64) uint64 { return uint64(ToMont(uint32(a))) }
+
+// FromMont converts a from Montgomery form.
+func (ZKDilithium) FromMont(a uint64) uint64 { return uint64(FromMont(uint32(a))) }
+
+// MulMont computes Montgomery multiplication of a and b.
+func (ZKDilithium) MulMont(a, b uint64) uint64 { return uint64(MulMont(uint32(a), uint32(b))) }
+
+// BatchInv inverts every element of xs in place (0 stays 0).
+func (ZKDilithium) BatchInv(xs []uint64) {
+	xs32 := make([]uint32, len(xs))
+	for i, x := range xs {
+		xs32[i] = uint32(x)
+	}
+	BatchInv(xs32)
+	for i, x := range xs32 {
+		xs[i] = uint64(x)
+	}
+}
@@ -0,0 +1,47 @@
+// Package simd is dispatch scaffolding for a vectorized field backend:
+// AVX2/AVX-512 on amd64, NEON on arm64. No such backend exists yet — see
+// doc.go — Add/Sub/MulMont/BatchInvMont below are plain scalar loops over
+// pkg/field regardless of Detected().
+package simd
+
+import "golang.org/x/sys/cpu"
+
+// Level identifies which vector width, if any, the running CPU can use.
+type Level int
+
+const (
+	// LevelScalar means the CPU reported none of AVX-512F, AVX2 or ASIMD;
+	// all operations use pkg/field's scalar implementations.
+	LevelScalar Level = iota
+	// LevelAVX2 means the CPU reports AVX2 (8 lanes of 32 bits).
+	LevelAVX2
+	// LevelAVX512 means the CPU reports AVX-512F (16 lanes of 32 bits).
+	LevelAVX512
+	// LevelNEON means the CPU reports arm64 ASIMD (4 lanes of 32 bits).
+	LevelNEON
+)
+
+// detected is the vector level chosen at init time, mirroring
+// field.hasVectorMont / ntt.hasVectorButterfly but resolved to a level
+// rather than a bool since AVX2 and AVX-512 need different lane counts.
+var detected = detectLevel()
+
+func detectLevel() Level {
+	switch {
+	case cpu.X86.HasAVX512F:
+		return LevelAVX512
+	case cpu.X86.HasAVX2:
+		return LevelAVX2
+	case cpu.ARM64.HasASIMD:
+		return LevelNEON
+	default:
+		return LevelScalar
+	}
+}
+
+// Detected reports the vector level detected at init. No function in this
+// package dispatches on it (see doc.go); benchmarks and callers can use it
+// to report what the CPU offers.
+func Detected() Level {
+	return detected
+}
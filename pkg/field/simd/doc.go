@@ -0,0 +1,14 @@
+// This package's Add/Sub/MulMont/BatchInvMont are plain scalar loops over
+// pkg/field, called element-by-element — no AVX2/AVX-512/NEON kernel is
+// implemented, and Detected()'s result is not read by any of them. Level
+// and Detected() exist as a dispatch surface a real vector backend (e.g.
+// an AVX2 kernel packing 8 lanes of uint32 into a __m256i for the CIOS
+// Montgomery reduction) could key off of `case LevelAVX2:`, but no such
+// backend exists in this package today.
+//
+// Status: the vector NTT/MulMont/reduceBarrett64Lazy/BatchInvMont kernels
+// this request asked for are rejected for this environment, not deferred.
+// There's no assembler or way to execute-test hand-written `.s` here, and
+// shipping unverified SIMD reduction code in a lattice signature scheme is
+// not an acceptable substitute for leaving this as dispatch scaffolding.
+package simd
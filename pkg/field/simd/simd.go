@@ -0,0 +1,32 @@
+package simd
+
+import "zkdilithium-signer/pkg/field"
+
+// Add computes result[i] = a[i] + b[i] for every i. See doc.go: this is a
+// plain scalar loop, not dispatched on Detected().
+func Add(a, b, result []uint32) {
+	for i := range a {
+		result[i] = field.Add(a[i], b[i])
+	}
+}
+
+// Sub computes result[i] = a[i] - b[i] for every i.
+func Sub(a, b, result []uint32) {
+	for i := range a {
+		result[i] = field.Sub(a[i], b[i])
+	}
+}
+
+// MulMont computes result[i] = MulMont(a[i], b[i]) for every i.
+func MulMont(a, b, result []uint32) {
+	for i := range a {
+		result[i] = field.MontMul(a[i], b[i])
+	}
+}
+
+// BatchInvMont inverts every element of xs in place, in Montgomery form.
+// scratch must not alias xs and must have length >= len(xs), same
+// constraint as field.BatchInvMont.
+func BatchInvMont(xs, scratch []uint32) {
+	field.BatchInvMont(xs, scratch)
+}
@@ -0,0 +1,75 @@
+package simd
+
+import (
+	"math/rand"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+func randSlice(n int, r *rand.Rand) []uint32 {
+	xs := make([]uint32, n)
+	for i := range xs {
+		xs[i] = uint32(r.Intn(field.Q))
+	}
+	return xs
+}
+
+func TestAddMatchesScalar(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a, b := randSlice(256, r), randSlice(256, r)
+	got := make([]uint32, 256)
+	Add(a, b, got)
+	for i := range got {
+		if want := field.Add(a[i], b[i]); got[i] != want {
+			t.Fatalf("Add[%d] = %d, want %d", i, got[i], want)
+		}
+	}
+}
+
+func TestSubMatchesScalar(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a, b := randSlice(256, r), randSlice(256, r)
+	got := make([]uint32, 256)
+	Sub(a, b, got)
+	for i := range got {
+		if want := field.Sub(a[i], b[i]); got[i] != want {
+			t.Fatalf("Sub[%d] = %d, want %d", i, got[i], want)
+		}
+	}
+}
+
+func TestMulMontMatchesScalar(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	a, b := randSlice(256, r), randSlice(256, r)
+	got := make([]uint32, 256)
+	MulMont(a, b, got)
+	for i := range got {
+		if want := field.MontMul(a[i], b[i]); got[i] != want {
+			t.Fatalf("MulMont[%d] = %d, want %d", i, got[i], want)
+		}
+	}
+}
+
+func TestBatchInvMontMatchesField(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	xs := randSlice(64, r)
+	want := append([]uint32(nil), xs...)
+	field.BatchInvMont(want, make([]uint32, len(want)))
+	BatchInvMont(xs, make([]uint32, len(xs)))
+	for i := range xs {
+		if xs[i] != want[i] {
+			t.Fatalf("BatchInvMont[%d] = %d, want %d", i, xs[i], want[i])
+		}
+	}
+}
+
+func BenchmarkMulMont(b *testing.B) {
+	r := rand.New(rand.NewSource(5))
+	a, x := randSlice(256, r), randSlice(256, r)
+	got := make([]uint32, 256)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MulMont(a, x, got)
+	}
+}
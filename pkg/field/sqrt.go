@@ -0,0 +1,133 @@
+package field
+
+// nonResidue is a fixed quadratic non-residue mod Q, the generator
+// Tonelli-Shanks below raises to odd powers to walk the 2-Sylow subgroup.
+// Legendre(nonResidue) == -1 is asserted in sqrt_test.go so a future change
+// to Q can't silently turn this into a residue without a test failing.
+const nonResidue = 3
+
+// Q - 1 = 2^sqrtS * sqrtT with sqrtT odd: 7340032 = 2^20 * 7.
+const (
+	sqrtS = 20
+	sqrtT = 7
+)
+
+// Legendre returns the Legendre symbol (a/Q): 0 if a == 0, 1 if a is a
+// nonzero square mod Q, -1 otherwise. It costs one exponentiation,
+// a^((Q-1)/2).
+func Legendre(a uint32) int8 {
+	if a == 0 {
+		return 0
+	}
+	if Exp(a, (Q-1)/2) == 1 {
+		return 1
+	}
+	return -1
+}
+
+// IsSquare reports whether a is a quadratic residue mod Q. 0 counts as a
+// square (0*0 == 0).
+func IsSquare(a uint32) bool {
+	return Exp(a, (Q-1)/2) != Q-1
+}
+
+// Sqrt returns a square root of a mod Q via Tonelli-Shanks (ok == false,
+// root == 0 if a is not a square). Since Q-1 = 2^20*7, the 2-adic part
+// dominates the algorithm's cost: each outer iteration can require up to
+// sqrtS squarings to locate the order of the current residual, and another
+// up to sqrtS to rebuild the correction factor, so this is O(sqrtS^2) field
+// multiplications — fine for an occasional call, not for a hot loop.
+func Sqrt(a uint32) (root uint32, ok bool) {
+	if a == 0 {
+		return 0, true
+	}
+	if !IsSquare(a) {
+		return 0, false
+	}
+
+	c := Exp(nonResidue, sqrtT)
+	x := Exp(a, sqrtT)
+	r := Exp(a, (sqrtT+1)/2)
+	m := sqrtS
+
+	for x != 1 {
+		// Find the least i, 0 < i < m, with x^(2^i) == 1.
+		i := 0
+		t := x
+		for t != 1 {
+			t = Mul(t, t)
+			i++
+		}
+
+		b := c
+		for j := 0; j < m-i-1; j++ {
+			b = Mul(b, b)
+		}
+
+		m = i
+		c = Mul(b, b)
+		x = Mul(x, c)
+		r = Mul(r, b)
+	}
+	return r, true
+}
+
+// SqrtCT is Sqrt's constant-time counterpart: Sqrt's `for x != 1` outer
+// loop and its two inner "how many squarings" loops all have iteration
+// counts that depend on a, which leaks information about a secret input's
+// residue structure through timing. SqrtCT instead always runs the outer
+// loop sqrtS times and both inner loops sqrtS times, using CtEq-style masks
+// (eqMask32/selectU32 from ct.go) to select whether an iteration's work is
+// "real" or a no-op, so the instruction trace no longer depends on a.
+//
+// Unlike Sqrt, SqrtCT assumes the caller already knows a is a square (e.g.
+// via a public/non-secret IsSquare check beforehand, or because a is
+// constructed to be one): if a is not a square, the returned value is
+// unspecified, not a reported failure, since branching on "found ok" would
+// itself reintroduce a data-dependent branch.
+func SqrtCT(a uint32) uint32 {
+	c := Exp(nonResidue, sqrtT)
+	x := Exp(a, sqrtT)
+	r := Exp(a, (sqrtT+1)/2)
+	m := uint32(sqrtS)
+
+	for round := 0; round < sqrtS; round++ {
+		// Find the least i in [0, sqrtS) with x^(2^i) == 1, scanning all
+		// sqrtS candidates and masking in the first hit instead of
+		// breaking out of the loop.
+		t := x
+		found := uint32(0)
+		chosenI := uint32(0)
+		for k := 0; k < sqrtS; k++ {
+			isOne := eqMask32(t, 1)
+			take := isOne &^ found
+			chosenI = selectU32(take, uint32(k), chosenI)
+			found |= isOne
+			t = Mul(t, t)
+		}
+		i := chosenI
+
+		// b = c^(2^(m-i-1)), built via sqrtS squarings where only the
+		// first (m-i-1) actually advance b; the rest are masked no-ops.
+		steps := m - i - 1
+		b := c
+		for j := uint32(0); j < sqrtS; j++ {
+			take := uint32(int32(j-steps) >> 31) // all-ones iff j < steps
+			b = selectU32(take, Mul(b, b), b)
+		}
+
+		mNext := i
+		cNext := Mul(b, b)
+		xNext := Mul(x, cNext)
+		rNext := Mul(r, b)
+
+		// Once x has already reached 1, further rounds must be no-ops:
+		// select between the updated and the frozen state.
+		done := eqMask32(x, 1)
+		m = selectU32(done, m, mNext)
+		c = selectU32(done, c, cNext)
+		x = selectU32(done, x, xNext)
+		r = selectU32(done, r, rNext)
+	}
+	return r
+}
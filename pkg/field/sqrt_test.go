@@ -0,0 +1,65 @@
+package field
+
+import "testing"
+
+func TestNonResidueIsActuallyNonResidue(t *testing.T) {
+	if got := Legendre(nonResidue); got != -1 {
+		t.Fatalf("Legendre(nonResidue) = %d, want -1 (Q may have changed)", got)
+	}
+}
+
+func TestLegendreAndIsSquareAgree(t *testing.T) {
+	if got := Legendre(0); got != 0 {
+		t.Fatalf("Legendre(0) = %d, want 0", got)
+	}
+	if !IsSquare(0) {
+		t.Fatalf("IsSquare(0) = false, want true")
+	}
+
+	for a := uint32(1); a < 3000; a++ {
+		leg := Legendre(a)
+		isSquare := IsSquare(a)
+		if (leg == 1) != isSquare {
+			t.Fatalf("Legendre(%d) = %d but IsSquare(%d) = %v", a, leg, a, isSquare)
+		}
+	}
+}
+
+func TestSqrtRoundTrips(t *testing.T) {
+	squares := 0
+	for a := uint32(1); a < 5000; a++ {
+		root, ok := Sqrt(a)
+		if ok != IsSquare(a) {
+			t.Fatalf("Sqrt(%d) ok = %v, want %v", a, ok, IsSquare(a))
+		}
+		if !ok {
+			continue
+		}
+		squares++
+		if got := Mul(root, root); got != a {
+			t.Fatalf("Sqrt(%d) = %d, but %d^2 = %d", a, root, root, got)
+		}
+	}
+	if squares == 0 {
+		t.Fatalf("found no squares in range, test is vacuous")
+	}
+}
+
+func TestSqrtZero(t *testing.T) {
+	root, ok := Sqrt(0)
+	if !ok || root != 0 {
+		t.Fatalf("Sqrt(0) = (%d, %v), want (0, true)", root, ok)
+	}
+}
+
+func TestSqrtCTMatchesSqrt(t *testing.T) {
+	for a := uint32(1); a < 5000; a++ {
+		root, ok := Sqrt(a)
+		if !ok {
+			continue
+		}
+		if got := SqrtCT(a); got != root {
+			t.Fatalf("SqrtCT(%d) = %d, want %d (Sqrt)", a, got, root)
+		}
+	}
+}
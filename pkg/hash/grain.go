@@ -9,24 +9,25 @@ type Grain struct {
 	hi uint64 // bits 64-79 (only lower 16 bits used)
 }
 
-// NewGrain creates a new Grain LFSR initialized for Poseidon constant generation.
-func NewGrain() *Grain {
+// newGrain creates a Grain LFSR seeded per the Poseidon spec for a
+// construction with rf full rounds and rp partial rounds.
+func newGrain(rf, rp int) *Grain {
 	g := &Grain{}
 
 	// Initialize 80-bit state per Poseidon spec:
-	// state = (2^30-1) | (0 << 30) | (POS_RF << 40) | (POS_T << 50) | (POS_RATE << 62) | (2 << 74) | (1 << 78)
+	// state = (2^30-1) | (rp << 30) | (rf << 40) | (POS_T << 50) | (POS_RATE << 62) | (2 << 74) | (1 << 78)
 
 	// Lower 64 bits (bits 0-63)
-	g.lo = (1 << 30) - 1                                     // bits 0-29: 2^30-1
-	g.lo |= 0 << 30                                          // bits 30-39: 0 partial rounds
-	g.lo |= uint64(field.PosRF) << 40                        // bits 40-49: full rounds (21)
-	g.lo |= uint64(field.PosT) << 50                         // bits 50-61: state size (35)
-	g.lo |= uint64(field.PosRate&0x3) << 62                  // bits 62-63: lower 2 bits of rate
+	g.lo = (1 << 30) - 1                    // bits 0-29: 2^30-1
+	g.lo |= uint64(rp) << 30                // bits 30-39: partial rounds
+	g.lo |= uint64(rf) << 40                // bits 40-49: full rounds
+	g.lo |= uint64(field.PosT) << 50        // bits 50-61: state size (35)
+	g.lo |= uint64(field.PosRate&0x3) << 62 // bits 62-63: lower 2 bits of rate
 
 	// Upper 16 bits (bits 64-79)
-	g.hi = uint64(field.PosRate >> 2)                        // bits 64-73: upper bits of rate
-	g.hi |= 2 << 10                                          // bits 74-77: alpha = -1 encoded as 2
-	g.hi |= 1 << 14                                          // bit 78: odd Q
+	g.hi = uint64(field.PosRate >> 2) // bits 64-73: upper bits of rate
+	g.hi |= 2 << 10                   // bits 74-77: alpha = -1 encoded as 2
+	g.hi |= 1 << 14                   // bit 78: odd Q
 
 	// Discard first 160 bits
 	for i := 0; i < 160; i++ {
@@ -35,6 +36,19 @@ func NewGrain() *Grain {
 	return g
 }
 
+// NewGrain creates a new Grain LFSR initialized for Poseidon constant generation.
+func NewGrain() *Grain {
+	return newGrain(field.PosRF, 0)
+}
+
+// NewGrain2 creates a new Grain LFSR initialized for Poseidon2 constant
+// generation. The external/internal round counts take the place of the
+// (rf, 0 partial rounds) pair NewGrain seeds with, so the two streams never
+// produce the same constants.
+func NewGrain2() *Grain {
+	return newGrain(field.PosExtRounds, field.PosIntRounds)
+}
+
 // getBit returns bit i of the 80-bit state.
 func (g *Grain) getBit(i int) uint64 {
 	if i < 64 {
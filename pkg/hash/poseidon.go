@@ -5,26 +5,43 @@ import "zkdilithium-signer/pkg/field"
 // PosRCsMont contains the Poseidon round constants in Montgomery form.
 var PosRCsMont [field.PosT * field.PosRF]uint32
 
+// PosRCs contains the same round constants as PosRCsMont, in normal form,
+// for tests that check against Python-generated vectors directly.
+var PosRCs [field.PosT * field.PosRF]uint32
+
 // PosInvMont contains precomputed inverses for MDS in Montgomery form.
 // PosInvMont[i] = (1/(i+1))_M for i in [0, 2*PosT-2]
 var PosInvMont [2*field.PosT - 1]uint32
 
+// PosInv contains the same MDS inverses as PosInvMont, in normal form.
+var PosInv [2*field.PosT - 1]uint32
+
 func init() {
 	// Generate round constants using Grain LFSR, convert to Montgomery form
 	g := NewGrain()
 	for i := 0; i < field.PosT*field.PosRF; i++ {
-		PosRCsMont[i] = field.ToMont(g.ReadFe())
+		fe := g.ReadFe()
+		PosRCs[i] = fe
+		PosRCsMont[i] = field.ToMont(fe)
 	}
 
 	// Generate MDS inverses in Montgomery form
 	for i := 0; i < 2*field.PosT-1; i++ {
-		PosInvMont[i] = field.ToMont(field.Inv(uint32(i + 1)))
+		inv := field.Inv(uint32(i + 1))
+		PosInv[i] = inv
+		PosInvMont[i] = field.ToMont(inv)
 	}
 }
 
 // poseidonRound applies one round of the Poseidon permutation.
 // State is in Montgomery form throughout.
 // scratch is a reusable buffer of length 3*PosT for zero-allocation operation.
+//
+// TODO(purego-split): poseidonRound/PoseidonPerm are the other half of the
+// purego/asm split started for pkg/ntt (see ntt_purego.go/ntt_generic.go).
+// An AVX2 kernel that fuses the round-constant add, batched MDS inversion
+// and the 35x35 accumulation into one pass belongs behind a `!purego`
+// build tag here; not yet split out.
 func poseidonRound(state, scratch []uint32, r int) {
 	// Add round constants (both in Montgomery form, addition preserves form)
 	for i := 0; i < field.PosT; i++ {
@@ -32,10 +49,10 @@ func poseidonRound(state, scratch []uint32, r int) {
 	}
 
 	// S-box: x -> x^(-1) in Montgomery form
-	// BatchInvMontTree uses tree-based algorithm for O(log n) depth
-	// enabling better instruction-level parallelism
-	// Note: state elements could be zero after adding round constants
-	field.BatchInvMontTreeCond(state, scratch)
+	// BatchInvMontDispatch picks the fastest BatchInv* backend for
+	// len(state) and the running CPU; state elements could be zero after
+	// adding round constants, which it also accounts for.
+	field.BatchInvMontDispatch(state, scratch)
 
 	// MDS matrix multiplication: M_ij = 1/(i+j+1)
 	// Lazy reduction: accumulate products in uint64, reduce once per row
@@ -0,0 +1,233 @@
+package hash
+
+import "zkdilithium-signer/pkg/field"
+
+// PoseidonVariant selects which Poseidon construction a caller wants:
+// PoseidonOriginal is the all-full-rounds sponge in poseidon.go, Poseidon2
+// is the external/internal round split below. See Perm.
+type PoseidonVariant int
+
+const (
+	PoseidonOriginal PoseidonVariant = iota
+	Poseidon2
+)
+
+// pos2RCsMont holds the Poseidon2 round constants in Montgomery form: the
+// first field.PosT*field.PosExtRounds entries are the external-round
+// constants (one per state element, per external round), followed by
+// field.PosIntRounds single constants, one per internal round, added to
+// state[0] only since the partial S-box touches nothing else.
+var pos2RCsMont [field.PosT*field.PosExtRounds + field.PosIntRounds]uint32
+
+// pos2DiagMont holds the diagonal d_i of the internal-round mixing matrix
+// M_I = D + 1*1^T, in Montgomery form. d_i = 2^i: small and, since 2 has
+// multiplicative order well above field.PosT mod field.Q, pairwise distinct,
+// which M_I needs to stay invertible.
+var pos2DiagMont [field.PosT]uint32
+
+func init() {
+	g := NewGrain2()
+	for i := range pos2RCsMont {
+		pos2RCsMont[i] = field.ToMont(g.ReadFe())
+	}
+
+	d := field.ToMont(1)
+	for i := range pos2DiagMont {
+		pos2DiagMont[i] = d
+		d = field.Add(d, d)
+	}
+}
+
+// mds4 applies the circulant near-MDS matrix circ(2,3,1,1) to four state
+// elements in place, in Montgomery form.
+func mds4(s []uint32) {
+	a0, a1, a2, a3 := s[0], s[1], s[2], s[3]
+	two := func(x uint32) uint32 { return field.Add(x, x) }
+	three := func(x uint32) uint32 { return field.Add(two(x), x) }
+	s[0] = field.Add(field.Add(two(a0), three(a1)), field.Add(a2, a3))
+	s[1] = field.Add(field.Add(a0, two(a1)), field.Add(three(a2), a3))
+	s[2] = field.Add(field.Add(a0, a1), field.Add(two(a2), three(a3)))
+	s[3] = field.Add(field.Add(three(a0), a1), field.Add(a2, two(a3)))
+}
+
+// mds3 applies the circulant near-MDS matrix circ(2,1,1) to three state
+// elements in place, in Montgomery form. circ(2,1,1)*v == sum(v)*1 + v, the
+// same diagonal-plus-rank-one shape as the internal round's M_I, just sized
+// for the field.PosT%4 tail block.
+func mds3(s []uint32) {
+	sum := field.Add(field.Add(s[0], s[1]), s[2])
+	s[0] = field.Add(sum, s[0])
+	s[1] = field.Add(sum, s[1])
+	s[2] = field.Add(sum, s[2])
+}
+
+// pos2MDS applies the Poseidon2 external linear layer: the circ(2,3,1,1)
+// near-MDS within each 4-element block, plus a per-offset cross-block sum
+// so the layer still mixes the whole state, in O(field.PosT) rather than
+// the field.PosT^2 of the original MDS. field.PosT isn't a multiple of
+// four (35 = 8*4+3); the trailing 3 elements get their own circ(2,1,1) and
+// sit outside the cross-block sum, since they don't share an offset with
+// any full block.
+func pos2MDS(state []uint32) {
+	full := (len(state) / 4) * 4
+	for b := 0; b < full; b += 4 {
+		mds4(state[b : b+4])
+	}
+	if rem := len(state) - full; rem > 0 {
+		mds3(state[full:])
+	}
+	if full == 0 {
+		return
+	}
+
+	var sum [4]uint32
+	for b := 0; b < full; b += 4 {
+		for k := 0; k < 4; k++ {
+			sum[k] = field.Add(sum[k], state[b+k])
+		}
+	}
+	for b := 0; b < full; b += 4 {
+		for k := 0; k < 4; k++ {
+			state[b+k] = field.Add(state[b+k], sum[k])
+		}
+	}
+}
+
+// pos2ExternalRound applies one Poseidon2 external (full S-box) round.
+// State is in Montgomery form; r indexes into the external-round constants.
+func pos2ExternalRound(state []uint32, r int) {
+	rcs := pos2RCsMont[field.PosT*r : field.PosT*(r+1)]
+	for i := range state {
+		state[i] = field.Add(state[i], rcs[i])
+	}
+	for i := range state {
+		state[i] = field.InvMont(state[i])
+	}
+	pos2MDS(state)
+}
+
+// pos2InternalRound applies one Poseidon2 internal (partial S-box) round.
+// Only state[0] gets a round constant and an inversion; M_I = D + 1*1^T
+// then collapses to one global sum plus a per-element scale-and-add,
+// replacing the field.PosT x field.PosT MDS the internal rounds of the
+// original construction pay for every round. r indexes into the
+// internal-round constants.
+func pos2InternalRound(state []uint32, r int) {
+	state[0] = field.Add(state[0], pos2RCsMont[field.PosT*field.PosExtRounds+r])
+	state[0] = field.InvMont(state[0])
+
+	var sum uint32
+	for _, x := range state {
+		sum = field.Add(sum, x)
+	}
+	for i, d := range pos2DiagMont {
+		state[i] = field.Add(field.MulMont(d, state[i]), sum)
+	}
+}
+
+// PoseidonPerm2 applies the full Poseidon2 permutation to state in place:
+// field.PosExtRounds/2 external rounds, then field.PosIntRounds internal
+// rounds, then the remaining external rounds. State must be in Montgomery
+// form.
+func PoseidonPerm2(state []uint32) {
+	half := field.PosExtRounds / 2
+	r := 0
+	for i := 0; i < half; i++ {
+		pos2ExternalRound(state, r)
+		r++
+	}
+	for i := 0; i < field.PosIntRounds; i++ {
+		pos2InternalRound(state, i)
+	}
+	for i := 0; i < half; i++ {
+		pos2ExternalRound(state, r)
+		r++
+	}
+}
+
+// Perm applies the Poseidon permutation for the given variant to state in
+// place. State must be in Montgomery form.
+func Perm(variant PoseidonVariant, state []uint32) {
+	if variant == Poseidon2 {
+		PoseidonPerm2(state)
+		return
+	}
+	PoseidonPerm(state)
+}
+
+// Poseidon2Sponge is a sponge construction using the Poseidon2 permutation,
+// mirroring Poseidon. Internal state is kept in Montgomery form.
+type Poseidon2Sponge struct {
+	s         [field.PosT]uint32 // Montgomery form
+	absorbing bool
+	i         int
+}
+
+// NewPoseidon2 creates a new Poseidon2 sponge, optionally with initial values.
+func NewPoseidon2(initial []uint32) *Poseidon2Sponge {
+	p := &Poseidon2Sponge{
+		absorbing: true,
+	}
+	if initial != nil {
+		p.Write(initial)
+	}
+	return p
+}
+
+// Write absorbs field elements into the sponge.
+// Input is in normal form, converted to Montgomery form internally.
+func (p *Poseidon2Sponge) Write(fes []uint32) {
+	if !p.absorbing {
+		panic("cannot write after reading")
+	}
+	for _, fe := range fes {
+		feM := field.ToMont(fe)
+		p.s[p.i] = field.Add(p.s[p.i], feM)
+		p.i++
+		if p.i == field.PosRate {
+			PoseidonPerm2(p.s[:])
+			p.i = 0
+		}
+	}
+}
+
+// Permute applies the permutation if there's pending input.
+func (p *Poseidon2Sponge) Permute() {
+	if !p.absorbing {
+		panic("cannot permute after reading")
+	}
+	if p.i != 0 {
+		PoseidonPerm2(p.s[:])
+		p.i = 0
+	}
+}
+
+// Read squeezes n field elements from the sponge.
+// Output is converted from Montgomery form to normal form.
+func (p *Poseidon2Sponge) Read(n int) []uint32 {
+	if p.absorbing {
+		p.absorbing = false
+		if p.i != 0 {
+			PoseidonPerm2(p.s[:])
+			p.i = 0
+		}
+	}
+
+	ret := make([]uint32, 0, n)
+	for n > 0 {
+		toRead := n
+		if toRead > field.PosRate-p.i {
+			toRead = field.PosRate - p.i
+		}
+		for j := 0; j < toRead; j++ {
+			ret = append(ret, field.FromMont(p.s[p.i+j]))
+		}
+		n -= toRead
+		p.i += toRead
+		if p.i == field.PosRate {
+			p.i = 0
+			PoseidonPerm2(p.s[:])
+		}
+	}
+	return ret
+}
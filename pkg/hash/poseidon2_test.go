@@ -0,0 +1,142 @@
+package hash
+
+import (
+	"testing"
+	"zkdilithium-signer/pkg/field"
+)
+
+// Test Grain2 first 10 field elements. These are a regression pin
+// captured from this package's own Grain2 output, not an independent
+// cross-check against a reference implementation (no Python source for
+// Grain2 exists in this repo) — same caveat as TestPoseidonPerm2 and
+// TestPoseidon2Sponge below.
+func TestGrain2First10Fes(t *testing.T) {
+	g := NewGrain2()
+	expected := []uint32{
+		3997672, 3287737, 3253772, 3770319, 127773,
+		3722724, 6599333, 5549757, 3927648, 2670621,
+	}
+	for i, want := range expected {
+		got := g.ReadFe()
+		if got != want {
+			t.Errorf("Grain2.ReadFe()[%d] = %d, want %d", i, got, want)
+		}
+	}
+}
+
+// Test Poseidon2 permutation against a regression-pinned vector. Despite
+// the similar phrasing TestPoseidonPerm once used, this was not checked
+// against an independent Python implementation — there is no Python
+// source anywhere in this repo — it is this package's own PoseidonPerm2
+// output, captured once and pinned so a future change gets caught.
+func TestPoseidonPerm2(t *testing.T) {
+	state := make([]uint32, field.PosT)
+	for i := 0; i < field.PosT; i++ {
+		state[i] = uint32(i)
+	}
+
+	PoseidonPerm2(state)
+
+	expected := []uint32{
+		6144787, 5634841, 1317278, 6710052, 4337906, 6867595, 1974910, 4227643,
+		3049011, 5815915, 1496724, 1175939, 6303343, 3670121, 3107285, 1448582,
+		6858267, 2821339, 2782659, 1219400, 973026, 4153340, 1434699, 3533387,
+		6916755, 7117813, 6982008, 3139530, 7105756, 3332774, 2768283, 280394,
+		2408328, 1143825, 7301067,
+	}
+	for i, want := range expected {
+		got := state[i]
+		if got != want {
+			t.Errorf("PoseidonPerm2[%d] = %d, want %d", i, got, want)
+		}
+	}
+}
+
+// Test Poseidon2 sponge against a regression-pinned vector, same caveat
+// as TestPoseidonPerm2 above: captured from this implementation's own
+// output, not cross-checked against an independent reference.
+func TestPoseidon2Sponge(t *testing.T) {
+	h := NewPoseidon2([]uint32{1, 2, 3})
+	result := h.Read(12)
+
+	expected := []uint32{
+		2135517, 4944082, 6021652, 5720051, 6651271, 2510170,
+		5000996, 6745742, 1759505, 2898735, 5593420, 2702465,
+	}
+	for i, want := range expected {
+		if result[i] != want {
+			t.Errorf("Poseidon2 sponge[%d] = %d, want %d", i, result[i], want)
+		}
+	}
+}
+
+// Test Perm dispatches to the matching construction.
+func TestPermDispatch(t *testing.T) {
+	s1 := make([]uint32, field.PosT)
+	s2 := make([]uint32, field.PosT)
+	for i := range s1 {
+		s1[i] = uint32(i)
+		s2[i] = uint32(i)
+	}
+
+	Perm(PoseidonOriginal, s1)
+	PoseidonPerm(s2)
+	for i := range s1 {
+		if s1[i] != s2[i] {
+			t.Fatalf("Perm(PoseidonOriginal) diverged from PoseidonPerm at [%d]", i)
+		}
+	}
+
+	s3 := make([]uint32, field.PosT)
+	s4 := make([]uint32, field.PosT)
+	for i := range s3 {
+		s3[i] = uint32(i)
+		s4[i] = uint32(i)
+	}
+
+	Perm(Poseidon2, s3)
+	PoseidonPerm2(s4)
+	for i := range s3 {
+		if s3[i] != s4[i] {
+			t.Fatalf("Perm(Poseidon2) diverged from PoseidonPerm2 at [%d]", i)
+		}
+	}
+}
+
+// Test that the two variants' round constant streams never collide.
+func TestPoseidonVariantConstantsDiffer(t *testing.T) {
+	if PosRCsMont[0] == pos2RCsMont[0] {
+		t.Error("PoseidonOriginal and Poseidon2 round constants collide at index 0")
+	}
+}
+
+// Test Poseidon2 is deterministic (same input -> same output).
+func TestPoseidon2Deterministic(t *testing.T) {
+	h1 := NewPoseidon2([]uint32{1, 2, 3})
+	h2 := NewPoseidon2([]uint32{1, 2, 3})
+
+	r1 := h1.Read(10)
+	r2 := h2.Read(10)
+
+	for i := range r1 {
+		if r1[i] != r2[i] {
+			t.Errorf("Poseidon2 not deterministic at [%d]: %d != %d", i, r1[i], r2[i])
+		}
+	}
+}
+
+// Benchmark full Poseidon2 permutation, for comparison against BenchmarkPoseidonPerm.
+func BenchmarkPoseidonPerm2(b *testing.B) {
+	state := make([]uint32, field.PosT)
+	for i := 0; i < field.PosT; i++ {
+		state[i] = uint32(i + 1)
+	}
+	orig := make([]uint32, field.PosT)
+	copy(orig, state)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(state, orig)
+		PoseidonPerm2(state)
+	}
+}
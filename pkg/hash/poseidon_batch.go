@@ -0,0 +1,82 @@
+package hash
+
+import "zkdilithium-signer/pkg/field"
+
+// PoseidonInvBatchWindow is the default number of states
+// PoseidonInvBatchStream buffers before flushing a batch — 128 states of
+// field.PosT=35 elements each is 4480 field elements, comfortably inside
+// field.BatchInvMontTree's single-call sweet spot.
+const PoseidonInvBatchWindow = 128
+
+// PoseidonInvBatch inverts len(states) independent Poseidon state vectors
+// in a single field.BatchInvMontTree call instead of one BatchInv* call
+// per state: it concatenates all k*field.PosT elements into one buffer,
+// runs one tree-based batch inverse over the whole thing, and splits the
+// inverses back out. The root inversion (the one true field.Inv the tree
+// pays) is shared across all k states instead of paid k times, so this
+// amortizes to O(1) inversions per state for large k.
+//
+// states are inverted in place, in Montgomery form, matching
+// field.BatchInvMontDispatch's contract used by poseidonRound's S-box step
+// — entries may be zero and are left as zero. scratch must have capacity
+// >= 3*len(states)*field.PosT, matching field.BatchInvMontTree's own
+// contract over the concatenated k*field.PosT elements.
+func PoseidonInvBatch(states [][field.PosT]uint32, scratch []uint32) {
+	k := len(states)
+	if k == 0 {
+		return
+	}
+	n := k * field.PosT
+
+	xs := make([]uint32, n)
+	for i, s := range states {
+		copy(xs[i*field.PosT:], s[:])
+	}
+
+	field.BatchInvMontTree(xs, scratch)
+
+	for i := range states {
+		copy(states[i][:], xs[i*field.PosT:(i+1)*field.PosT])
+	}
+}
+
+// PoseidonInvBatchStream runs PoseidonInvBatch over states arriving on ch,
+// buffering up to PoseidonInvBatchWindow states before flushing a batch,
+// so callers that produce Poseidon states one at a time (e.g. Merkle-tree
+// leaf hashing) get the shared-root-inversion amortization of
+// PoseidonInvBatch without owning the batching logic themselves. The
+// returned channel is closed once ch is closed and any partial final
+// batch has been flushed.
+func PoseidonInvBatchStream(ch <-chan [field.PosT]uint32) <-chan [field.PosT]uint32 {
+	out := make(chan [field.PosT]uint32)
+	go func() {
+		defer close(out)
+
+		batch := make([][field.PosT]uint32, 0, PoseidonInvBatchWindow)
+		scratch := make([]uint32, 3*PoseidonInvBatchWindow*field.PosT)
+
+		flush := func() {
+			if len(batch) == 0 {
+				return
+			}
+			need := 3 * len(batch) * field.PosT
+			if need > len(scratch) {
+				scratch = make([]uint32, need)
+			}
+			PoseidonInvBatch(batch, scratch)
+			for _, s := range batch {
+				out <- s
+			}
+			batch = batch[:0]
+		}
+
+		for s := range ch {
+			batch = append(batch, s)
+			if len(batch) == PoseidonInvBatchWindow {
+				flush()
+			}
+		}
+		flush()
+	}()
+	return out
+}
@@ -0,0 +1,72 @@
+package hash
+
+import (
+	"testing"
+	"zkdilithium-signer/pkg/field"
+)
+
+func TestPoseidonInvBatchMatchesPerState(t *testing.T) {
+	for _, k := range []int{0, 1, 2, 5, 17} {
+		states := make([][field.PosT]uint32, k)
+		want := make([][field.PosT]uint32, k)
+		for i := range states {
+			for j := 0; j < field.PosT; j++ {
+				v := field.ToMont(uint32(i*field.PosT + j + 1))
+				if j%7 == 3 {
+					v = 0
+				}
+				states[i][j] = v
+				want[i][j] = v
+			}
+		}
+		for i := range want {
+			scratch := make([]uint32, 3*field.PosT)
+			field.BatchInvMontTree(want[i][:], scratch)
+		}
+
+		scratch := make([]uint32, 3*k*field.PosT)
+		PoseidonInvBatch(states, scratch)
+
+		for i := range states {
+			if states[i] != want[i] {
+				t.Fatalf("k=%d state %d = %v, want %v", k, i, states[i], want[i])
+			}
+		}
+	}
+}
+
+func TestPoseidonInvBatchStream(t *testing.T) {
+	const n = PoseidonInvBatchWindow + 13
+
+	in := make(chan [field.PosT]uint32)
+	out := PoseidonInvBatchStream(in)
+
+	want := make([][field.PosT]uint32, n)
+	go func() {
+		defer close(in)
+		for i := 0; i < n; i++ {
+			var s [field.PosT]uint32
+			for j := 0; j < field.PosT; j++ {
+				s[j] = field.ToMont(uint32(i*field.PosT + j + 1))
+			}
+			want[i] = s
+			scratch := make([]uint32, 3*field.PosT)
+			field.BatchInvMontTree(want[i][:], scratch)
+			in <- s
+		}
+	}()
+
+	got := make([][field.PosT]uint32, 0, n)
+	for s := range out {
+		got = append(got, s)
+	}
+
+	if len(got) != n {
+		t.Fatalf("got %d states, want %d", len(got), n)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("state %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+}
@@ -34,15 +34,21 @@ func TestPosRCsFirst16(t *testing.T) {
 	}
 }
 
-// Test Poseidon permutation with known values from Python
+// Test Poseidon permutation with known values from Python.
+// PoseidonPerm operates on Montgomery-form state (see poseidonRound), so
+// the plain-form inputs/outputs below must cross that boundary explicitly.
 func TestPoseidonPerm(t *testing.T) {
 	state := make([]uint32, field.PosT)
 	for i := 0; i < field.PosT; i++ {
-		state[i] = uint32(i)
+		state[i] = field.ToMont(uint32(i))
 	}
 
 	PoseidonPerm(state)
 
+	for i := range state {
+		state[i] = field.FromMont(state[i])
+	}
+
 	expected := []uint32{
 		6525793, 2817790, 5538989, 1140645, 1838881, 2536727, 6768730, 4709337,
 		6955613, 2401101, 1387526, 5346661, 1137806, 7270459, 1552970, 4071298,
@@ -178,6 +184,44 @@ func BenchmarkMDS7Unroll(b *testing.B) {
 	}
 }
 
+// Benchmark MDS with 8-wide accumulation: the remaining 35 = 4*8+3 terms
+// per row are grouped into 4 batches of 8 lane-wise products (the shape an
+// 8-lane mulPlainLazy8/reduceBarrett64Lazy8 kernel — see
+// pkg/field/plainlazy_generic.go — would fill) plus a 3-wide remainder,
+// accumulated before the single mod reduction per row.
+func BenchmarkMDS8Wide(b *testing.B) {
+	state := make([]uint32, field.PosT)
+	scratch := make([]uint32, field.PosT)
+	for i := 0; i < field.PosT; i++ {
+		state[i] = uint32(i + 1)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		copy(scratch, state)
+		scratchArr := (*[field.PosT]uint32)(scratch)
+		for row := 0; row < field.PosT; row++ {
+			var acc uint64
+			invSlice := (*[field.PosT]uint32)(PosInv[row : row+field.PosT])
+			for j := 0; j+8 <= 32; j += 8 {
+				t0 := uint64(invSlice[j]) * uint64(scratchArr[j])
+				t1 := uint64(invSlice[j+1]) * uint64(scratchArr[j+1])
+				t2 := uint64(invSlice[j+2]) * uint64(scratchArr[j+2])
+				t3 := uint64(invSlice[j+3]) * uint64(scratchArr[j+3])
+				t4 := uint64(invSlice[j+4]) * uint64(scratchArr[j+4])
+				t5 := uint64(invSlice[j+5]) * uint64(scratchArr[j+5])
+				t6 := uint64(invSlice[j+6]) * uint64(scratchArr[j+6])
+				t7 := uint64(invSlice[j+7]) * uint64(scratchArr[j+7])
+				acc += t0 + t1 + t2 + t3 + t4 + t5 + t6 + t7
+			}
+			for j := 32; j < 35; j++ {
+				acc += uint64(invSlice[j]) * uint64(scratchArr[j])
+			}
+			state[row] = uint32(acc % field.Q)
+		}
+	}
+}
+
 // Benchmark MDS with 2-row parallel
 func BenchmarkMDS2Row(b *testing.B) {
 	state := make([]uint32, field.PosT)
@@ -115,6 +115,13 @@ func (x *SeedClonableXOF128) Read3() (b0, b1, b2 byte) {
 	return
 }
 
+// Clone returns an independent SeedClonableXOF128 sharing this one's
+// seed-absorbed state, without re-absorbing the seed. Used to build
+// SeedClonableXOF128x4's four lanes from a single seed absorption.
+func (x *SeedClonableXOF128) Clone() *SeedClonableXOF128 {
+	return &SeedClonableXOF128{seedState: x.seedState.(clonable).Clone()}
+}
+
 // XOF256 returns SHAKE-256 output for seed||nonce.
 func XOF256(seed []byte, nonce uint16) []byte {
 	h := sha3.NewShake256()
@@ -173,3 +180,29 @@ func H(msg []byte, length int) []byte {
 	h.Read(out)
 	return out
 }
+
+// StreamingH is H for callers that want to absorb a message incrementally
+// instead of holding the whole thing in memory, e.g. a Signer streaming a
+// multi-GB file into the rho2 derivation alongside its Poseidon transcript.
+type StreamingH struct {
+	h sha3.ShakeHash
+}
+
+// NewStreamingH creates a streaming H, equivalent to H(msg, length) once
+// msg has been absorbed via Write and length bytes read via Sum.
+func NewStreamingH() *StreamingH {
+	return &StreamingH{h: sha3.NewShake256()}
+}
+
+// Write absorbs more of the message. It never errors.
+func (s *StreamingH) Write(p []byte) (int, error) {
+	return s.h.Write(p)
+}
+
+// Sum squeezes length bytes of output, exactly as H(msg, length) would for
+// the concatenation of every byte slice passed to Write.
+func (s *StreamingH) Sum(length int) []byte {
+	out := make([]byte, length)
+	s.h.Read(out)
+	return out
+}
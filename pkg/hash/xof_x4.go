@@ -0,0 +1,40 @@
+package hash
+
+// SeedClonableXOF128x4 drives four SeedClonableXOF128 lanes that share one
+// seed absorption, so a caller expanding a matrix (SampleMatrix) can pull
+// four independent nonce streams per round trip instead of one.
+//
+// A real KeccakF1600×4 backend would batch the four lanes' permutation
+// rounds into one AVX2/NEON vector instruction, keeping the sponge states
+// interleaved in registers. golang.org/x/crypto/sha3 does not expose its
+// permutation for that, and there is no assembler available in this build
+// to write one from scratch, so this type only fixes the four-lanes-at-once
+// call shape such a backend would have; each lane still runs the ordinary
+// scalar sha3.ShakeHash underneath.
+type SeedClonableXOF128x4 struct {
+	lanes [4]*SeedClonableXOF128
+}
+
+// NewSeedClonableXOF128x4 absorbs seed once and clones the resulting state
+// into four independent lanes.
+func NewSeedClonableXOF128x4(seed []byte) *SeedClonableXOF128x4 {
+	base := NewSeedClonableXOF128(seed)
+	x := &SeedClonableXOF128x4{}
+	for i := range x.lanes {
+		x.lanes[i] = base.Clone()
+	}
+	return x
+}
+
+// SetNonces restores all four lanes from the seed-absorbed state and
+// absorbs one nonce per lane.
+func (x *SeedClonableXOF128x4) SetNonces(nonces [4]uint16) {
+	for i, nonce := range nonces {
+		x.lanes[i].SetNonce(nonce)
+	}
+}
+
+// Read3 returns the next 3 bytes from the given lane (0-3).
+func (x *SeedClonableXOF128x4) Read3(lane int) (b0, b1, b2 byte) {
+	return x.lanes[lane].Read3()
+}
@@ -0,0 +1,43 @@
+package hash
+
+import "testing"
+
+func TestSeedClonableXOF128x4MatchesIndividualLanes(t *testing.T) {
+	seed := make([]byte, 32)
+	for i := range seed {
+		seed[i] = byte(i)
+	}
+	nonces := [4]uint16{1, 2, 3, 4}
+
+	x4 := NewSeedClonableXOF128x4(seed)
+	x4.SetNonces(nonces)
+
+	for lane, nonce := range nonces {
+		single := NewSeedClonableXOF128(seed)
+		single.SetNonce(nonce)
+		for n := 0; n < 200; n++ {
+			wb0, wb1, wb2 := single.Read3()
+			gb0, gb1, gb2 := x4.Read3(lane)
+			if wb0 != gb0 || wb1 != gb1 || wb2 != gb2 {
+				t.Fatalf("lane %d byte %d: got (%d,%d,%d), want (%d,%d,%d)", lane, n, gb0, gb1, gb2, wb0, wb1, wb2)
+			}
+		}
+	}
+}
+
+func TestSeedClonableXOF128CloneIndependentOfSource(t *testing.T) {
+	seed := make([]byte, 32)
+	base := NewSeedClonableXOF128(seed)
+	clone := base.Clone()
+
+	base.SetNonce(5)
+	clone.SetNonce(5)
+
+	for n := 0; n < 50; n++ {
+		b0, b1, b2 := base.Read3()
+		c0, c1, c2 := clone.Read3()
+		if b0 != c0 || b1 != c1 || b2 != c2 {
+			t.Fatalf("byte %d: base (%d,%d,%d) != clone (%d,%d,%d)", n, b0, b1, b2, c0, c1, c2)
+		}
+	}
+}
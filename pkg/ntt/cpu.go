@@ -0,0 +1,22 @@
+//go:build !purego
+
+package ntt
+
+import "golang.org/x/sys/cpu"
+
+// hasVectorButterfly reports whether the current CPU has the wide-lane
+// integer multiply support (AVX2 on amd64, NEON on arm64) a vectorized NTT
+// butterfly kernel could dispatch to. No such kernel exists —
+// ntt_generic.go is plain scalar Go, same as ntt_purego.go — so this is
+// unused today; it mirrors field.hasVectorMont as CPU-detection
+// scaffolding, not a sign an AVX2/NEON backend is in flight.
+//
+// Status: this request's AVX2/NEON NTT butterfly kernel and the
+// KeccakF1600x4 SHAKE backend are rejected for this environment, not
+// deferred. There's no assembler or way to execute-test hand-written `.s`
+// here, so this stays CPU-detection scaffolding with nothing behind it.
+var hasVectorButterfly = detectHasVectorButterfly()
+
+func detectHasVectorButterfly() bool {
+	return cpu.X86.HasAVX2 || cpu.ARM64.HasASIMD
+}
@@ -0,0 +1,66 @@
+//go:build !purego
+
+package ntt
+
+import "zkdilithium-signer/pkg/field"
+
+// NTT computes the Number Theoretic Transform of a polynomial in place.
+//
+// This is the default (!purego) build, and it is scalar: byte-identical to
+// ntt_purego.go, not an AVX2 kernel. No vectorized Cooley-Tukey butterfly
+// implementation exists in this package yet. The build-tag split exists so
+// callers and benchmarks can already select between `purego` and default
+// builds ahead of one landing.
+//
+// Status: the AVX2 assembly half of this request (vectorized butterflies,
+// fused Poseidon round kernel, asm-vs-purego cross-check tests) is rejected
+// for this environment, not merely deferred. Hand-written `.s` is load-bearing
+// correctness code in a signature scheme; landing it without an assembler and
+// a way to execute-test it here would mean shipping unverified SIMD math in a
+// cryptographic primitive, which is worse than the honest no-op this build-tag
+// split already is.
+func NTT(cs *[field.N]uint32) {
+	layer := field.N / 2
+	zi := 0
+	for layer >= 1 {
+		for offset := 0; offset < field.N-layer; offset += 2 * layer {
+			z := ZetasMont[zi]
+			zi++
+
+			for j := offset; j < offset+layer; j++ {
+				t := field.MulMont(z, cs[j+layer])
+				cs[j+layer] = field.Sub(cs[j], t)
+				cs[j] = field.Add(cs[j], t)
+			}
+		}
+		layer /= 2
+	}
+}
+
+// InvNTT computes the inverse Number Theoretic Transform in place.
+// See NTT for the state of the AVX2 follow-up.
+func InvNTT(cs *[field.N]uint32) {
+	layer := 1
+	zi := 0
+	for layer < field.N {
+		for offset := 0; offset < field.N-layer; offset += 2 * layer {
+			z := InvZetasMont[zi]
+			zi++
+
+			for j := offset; j < offset+layer; j++ {
+				t := field.Sub(cs[j], cs[j+layer])
+				cs[j] = field.MulMont(Inv2Mont, field.Add(cs[j], cs[j+layer]))
+				inv2zMont := field.MulMont(Inv2Mont, z)
+				cs[j+layer] = field.MulMont(inv2zMont, t)
+			}
+		}
+		layer *= 2
+	}
+}
+
+// MulNTT performs componentwise multiplication of two polynomials in NTT domain.
+func MulNTT(a, b *[field.N]uint32, result *[field.N]uint32) {
+	for i := 0; i < field.N; i++ {
+		result[i] = field.Mul(a[i], b[i])
+	}
+}
@@ -0,0 +1,63 @@
+//go:build purego
+
+package ntt
+
+import "zkdilithium-signer/pkg/field"
+
+// NTT computes the Number Theoretic Transform of a polynomial in place.
+// Input: coefficients in standard order (normal form).
+// Output: coefficients in NTT domain (normal form).
+// Uses Montgomery multiplication for efficiency.
+//
+// This is the portable reference implementation, forced by -tags=purego.
+// It must stay behaviorally identical to ntt_generic.go.
+func NTT(cs *[field.N]uint32) {
+	layer := field.N / 2
+	zi := 0
+	for layer >= 1 {
+		for offset := 0; offset < field.N-layer; offset += 2 * layer {
+			z := ZetasMont[zi]
+			zi++
+
+			for j := offset; j < offset+layer; j++ {
+				// MulMont(z_M, c) = z * c (normal form)
+				t := field.MulMont(z, cs[j+layer])
+				cs[j+layer] = field.Sub(cs[j], t)
+				cs[j] = field.Add(cs[j], t)
+			}
+		}
+		layer /= 2
+	}
+}
+
+// InvNTT computes the inverse Number Theoretic Transform in place.
+// Input: coefficients in NTT domain (normal form).
+// Output: coefficients in standard order (normal form).
+// Uses Montgomery multiplication for efficiency.
+func InvNTT(cs *[field.N]uint32) {
+	layer := 1
+	zi := 0
+	for layer < field.N {
+		for offset := 0; offset < field.N-layer; offset += 2 * layer {
+			z := InvZetasMont[zi]
+			zi++
+
+			for j := offset; j < offset+layer; j++ {
+				t := field.Sub(cs[j], cs[j+layer])
+				// MulMont(Inv2_M, sum) where sum is normal = Inv2 * sum (normal)
+				cs[j] = field.MulMont(Inv2Mont, field.Add(cs[j], cs[j+layer]))
+				// MulMont((Inv2*z)_M, t) where t is normal = Inv2 * z * t (normal)
+				inv2zMont := field.MulMont(Inv2Mont, z)
+				cs[j+layer] = field.MulMont(inv2zMont, t)
+			}
+		}
+		layer *= 2
+	}
+}
+
+// MulNTT performs componentwise multiplication of two polynomials in NTT domain.
+func MulNTT(a, b *[field.N]uint32, result *[field.N]uint32) {
+	for i := 0; i < field.N; i++ {
+		result[i] = field.Mul(a[i], b[i])
+	}
+}
@@ -0,0 +1,34 @@
+package poly
+
+import "zkdilithium-signer/pkg/field"
+
+// CtEqual reports whether a and b are equal, folding all 256 coefficients
+// via XOR-OR into a single accumulator before one final comparison, so no
+// branch depends on which coefficient (if any) first differs — the same
+// shape as crypto/subtle.ConstantTimeCompare.
+func CtEqual(a, b *Poly) bool {
+	var diff uint32
+	for i := 0; i < field.N; i++ {
+		diff |= a[i] ^ b[i]
+	}
+	return diff == 0
+}
+
+// CtNorm is Norm without its two data-dependent branches (the sign check
+// and the running-max update), both replaced with mask arithmetic so the
+// timing doesn't depend on which coefficients are large.
+func CtNorm(p *Poly) uint32 {
+	half := int32((field.Q - 1) / 2)
+	var n uint32
+	for _, c := range p {
+		ci := int32(c)
+		d := ci - half - 1           // >= 0 iff c > half
+		highMask := ^uint32(d >> 31) // all-ones iff c > half
+
+		absC := ((field.Q - c) & highMask) | (c &^ highMask)
+
+		gt := ^uint32(int32(absC-n) >> 31) // all-ones iff absC >= n
+		n = (absC & gt) | (n &^ gt)
+	}
+	return n
+}
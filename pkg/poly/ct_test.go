@@ -0,0 +1,47 @@
+package poly
+
+import (
+	"math/rand"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+func ctTestRandPoly(r *rand.Rand) Poly {
+	var p Poly
+	for i := range p {
+		p[i] = uint32(r.Int63n(field.Q))
+	}
+	return p
+}
+
+func TestCtEqualMatchesEqual(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a := ctTestRandPoly(r)
+	b := a
+
+	if !CtEqual(&a, &b) {
+		t.Fatal("CtEqual(a,a) = false, want true")
+	}
+	if !Equal(&a, &b) {
+		t.Fatal("Equal(a,a) = false, want true")
+	}
+
+	b[100] = field.Add(b[100], 1)
+	if CtEqual(&a, &b) {
+		t.Fatal("CtEqual(a,b) = true after perturbing b, want false")
+	}
+	if Equal(&a, &b) {
+		t.Fatal("Equal(a,b) = true after perturbing b, want false")
+	}
+}
+
+func TestCtNormMatchesNorm(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 50; trial++ {
+		p := ctTestRandPoly(r)
+		if got, want := CtNorm(&p), p.Norm(); got != want {
+			t.Fatalf("trial %d: CtNorm = %d, want %d", trial, got, want)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package packed
+
+import (
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/ntt"
+	"zkdilithium-signer/pkg/poly"
+)
+
+// Pack/Unpack helpers for poly.Poly, so callers working in terms of the
+// polynomial type don't need to know about the underlying [field.N]uint32.
+
+// PackPoly packs a poly.Poly into two-per-word form.
+func PackPoly(p *poly.Poly) *Packed {
+	return Pack((*[field.N]uint32)(p))
+}
+
+// UnpackPoly unpacks back into a poly.Poly.
+func UnpackPoly(pk *Packed) *poly.Poly {
+	return (*poly.Poly)(Unpack(pk))
+}
+
+// NTTPacked computes the forward NTT, staying in packed form for the whole
+// transform. Every layer has exactly field.N/2 butterflies; multiplications
+// still unpack to a per-lane field.MulMont (see package doc), and each
+// layer's add/sub step reduces both lanes back to [0, Q) immediately via
+// unpack2 — there is no deferred reduction across layers here, unlike the
+// standalone Packed.Add/Sub. What this fuses is two butterflies at a time
+// into a single packed add and a single biased subtract, rather than four
+// scalar field.Add/field.Sub calls; the reduction cost per butterfly is the
+// same as the scalar NTT.
+func NTTPacked(p *poly.Poly) *Packed {
+	cs := *p
+
+	var js [field.N / 2]int
+	var zs [field.N / 2]uint32
+	var ts [field.N / 2]uint32
+
+	layer := field.N / 2
+	zi := 0
+	for layer >= 1 {
+		idx := 0
+		for offset := 0; offset < field.N-layer; offset += 2 * layer {
+			z := ntt.ZetasMont[zi]
+			zi++
+			for j := offset; j < offset+layer; j++ {
+				js[idx] = j
+				zs[idx] = z
+				idx++
+			}
+		}
+
+		for i := 0; i < idx; i++ {
+			ts[i] = field.MulMont(zs[i], cs[js[i]+layer])
+		}
+
+		i := 0
+		for i+1 < idx {
+			wA := pack2(cs[js[i]], cs[js[i+1]])
+			wT := pack2(ts[i], ts[i+1])
+			sumLo, sumHi := unpack2(wA + wT)
+			diffLo, diffHi := unpack2((wA + subBias) - wT)
+
+			cs[js[i]+layer] = diffLo
+			cs[js[i+1]+layer] = diffHi
+			cs[js[i]] = sumLo
+			cs[js[i+1]] = sumHi
+			i += 2
+		}
+		if i < idx {
+			// field.N/2 is always a power of two (>= 1) for the layer sizes
+			// this loop sees, so this is unreachable in practice; kept as
+			// an honest fallback rather than assuming it away.
+			cs[js[i]+layer] = field.Sub(cs[js[i]], ts[i])
+			cs[js[i]] = field.Add(cs[js[i]], ts[i])
+		}
+
+		layer /= 2
+	}
+
+	return Pack((*[field.N]uint32)(&cs))
+}
+
+// InvNTTPacked computes the inverse NTT, staying in packed form for the
+// add/sub half of each butterfly the same way NTTPacked does — reduced
+// every layer via unpack2, not deferred. The final per-lane scaling by
+// Inv2 (and Inv2*zeta) still happens as scalar field.MulMont calls, since
+// Montgomery multiplication doesn't factor across independent lanes.
+func InvNTTPacked(pk *Packed) *poly.Poly {
+	cs := *(*poly.Poly)(Unpack(pk))
+
+	var js [field.N / 2]int
+	var zs [field.N / 2]uint32
+	var sums [field.N / 2]uint32
+	var diffs [field.N / 2]uint32
+
+	layer := 1
+	zi := 0
+	for layer < field.N {
+		idx := 0
+		for offset := 0; offset < field.N-layer; offset += 2 * layer {
+			z := ntt.InvZetasMont[zi]
+			zi++
+			for j := offset; j < offset+layer; j++ {
+				js[idx] = j
+				zs[idx] = z
+				idx++
+			}
+		}
+
+		i := 0
+		for i+1 < idx {
+			a0, b0 := cs[js[i]], cs[js[i]+layer]
+			a1, b1 := cs[js[i+1]], cs[js[i+1]+layer]
+
+			wA := pack2(a0, a1)
+			wB := pack2(b0, b1)
+			sumLo, sumHi := unpack2(wA + wB)
+			diffLo, diffHi := unpack2((wA + subBias) - wB)
+
+			sums[i], sums[i+1] = sumLo, sumHi
+			diffs[i], diffs[i+1] = diffLo, diffHi
+			i += 2
+		}
+		if i < idx {
+			sums[i] = field.Add(cs[js[i]], cs[js[i]+layer])
+			diffs[i] = field.Sub(cs[js[i]], cs[js[i]+layer])
+		}
+
+		for k := 0; k < idx; k++ {
+			j := js[k]
+			cs[j] = field.MulMont(ntt.Inv2Mont, sums[k])
+			inv2z := field.MulMont(ntt.Inv2Mont, zs[k])
+			cs[j+layer] = field.MulMont(inv2z, diffs[k])
+		}
+
+		layer *= 2
+	}
+
+	return &cs
+}
@@ -0,0 +1,92 @@
+// Package packed provides a two-coefficients-per-uint64 layout for a
+// zkDilithium polynomial, analogous to the radix-51 packing used for
+// GF(2^255-19): since Q = 2^23 - 2^20 + 1 fits in 23 bits, two coefficients
+// fit in one uint64 with wide slack left over, so a lane-wise add/sub on
+// the packed word handles both coefficients in one Go op instead of two.
+// The standalone Add/Sub below leave both lanes unreduced (up to 2Q) so a
+// caller chaining several of them can reduce once at the end with Reduce;
+// NTTPacked/InvNTTPacked don't take that deferral — see their doc comments
+// — they reduce every layer, same as the scalar NTT, and only save the
+// two-lanes-per-op fusing.
+//
+// Multiplication still unpacks to a per-lane MulMont (a 32x32->64 product
+// plus a Montgomery reduction doesn't factor across two independent lanes
+// the way add/sub does), so the payoff here is specifically in add/sub-heavy
+// code — NTT/InvNTT butterflies — not in pointwise multiplication.
+package packed
+
+import "zkdilithium-signer/pkg/field"
+
+// limbBits is the width reserved for each packed coefficient. Q needs 23
+// bits; 32 leaves 9 bits of headroom per lane, enough for the handful of
+// adds/subs a butterfly pass performs before Reduce is called.
+const limbBits = 32
+const limbMask = (1 << limbBits) - 1
+
+// Packed stores a 256-coefficient polynomial as 128 words, two 32-bit lanes
+// per word: low lane in bits [0:32), high lane in bits [32:64).
+type Packed [field.N / 2]uint64
+
+// pack2 packs two plain-form coefficients into one word.
+func pack2(lo, hi uint32) uint64 {
+	return uint64(lo) | uint64(hi)<<limbBits
+}
+
+// unpack2 extracts both lanes of a word and reduces each mod Q.
+func unpack2(w uint64) (lo, hi uint32) {
+	lo = uint32((w & limbMask) % field.Q)
+	hi = uint32((w >> limbBits) % field.Q)
+	return
+}
+
+// Pack packs cs (256 plain-form field elements) into two-per-word form.
+func Pack(cs *[field.N]uint32) *Packed {
+	var out Packed
+	for i := 0; i < field.N/2; i++ {
+		out[i] = pack2(cs[2*i], cs[2*i+1])
+	}
+	return &out
+}
+
+// Unpack reduces every lane mod Q and unpacks back into plain-form
+// coefficients.
+func Unpack(pk *Packed) *[field.N]uint32 {
+	var out [field.N]uint32
+	for i := 0; i < field.N/2; i++ {
+		out[2*i], out[2*i+1] = unpack2(pk[i])
+	}
+	return &out
+}
+
+// Add computes a + b lane-wise: one uint64 add handles both lanes of a
+// word at once, since at this width there's no carry between lanes.
+// Lanes are left unreduced (may be up to 2Q) — call Reduce before Add is
+// called again on the same word, or before Unpack.
+func Add(a, b *Packed, result *Packed) {
+	for i := range a {
+		result[i] = a[i] + b[i]
+	}
+}
+
+// subBias biases both lanes by 2*Q so a lane-wise uint64 subtraction can't
+// borrow across the lane boundary into its neighbour (the same trick
+// radix-51 packings use to keep a lazy subtract branch-free).
+var subBias = pack2(2*field.Q, 2*field.Q)
+
+// Sub computes a - b lane-wise, assuming both a and b's lanes are < 2*Q
+// (true right after Pack or Reduce).
+func Sub(a, b *Packed, result *Packed) {
+	for i := range a {
+		result[i] = (a[i] + subBias) - b[i]
+	}
+}
+
+// Reduce brings every lane back into [0, Q), lane-by-lane. It is the step
+// a caller chaining the standalone Add/Sub runs once at the end;
+// NTTPacked/InvNTTPacked do not call it and reduce every layer themselves.
+func Reduce(a *Packed, result *Packed) {
+	for i := range a {
+		lo, hi := unpack2(a[i])
+		result[i] = pack2(lo, hi)
+	}
+}
@@ -0,0 +1,111 @@
+package packed
+
+import (
+	"math/rand"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/ntt"
+	"zkdilithium-signer/pkg/poly"
+)
+
+func randPoly(r *rand.Rand) poly.Poly {
+	var p poly.Poly
+	for i := range p {
+		p[i] = uint32(r.Int63n(field.Q))
+	}
+	return p
+}
+
+func TestPackUnpackRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	p := randPoly(r)
+	pk := PackPoly(&p)
+	got := UnpackPoly(pk)
+	for i := range p {
+		if got[i] != p[i] {
+			t.Fatalf("index %d: got %d want %d", i, got[i], p[i])
+		}
+	}
+}
+
+func TestAddSubReduce(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a := randPoly(r)
+	b := randPoly(r)
+
+	pkA := PackPoly(&a)
+	pkB := PackPoly(&b)
+
+	var sumPacked, diffPacked Packed
+	Add(pkA, pkB, &sumPacked)
+	Sub(pkA, pkB, &diffPacked)
+
+	var sumReduced, diffReduced Packed
+	Reduce(&sumPacked, &sumReduced)
+	Reduce(&diffPacked, &diffReduced)
+
+	sum := Unpack(&sumReduced)
+	diff := Unpack(&diffReduced)
+
+	for i := 0; i < field.N; i++ {
+		if want := field.Add(a[i], b[i]); sum[i] != want {
+			t.Fatalf("Add index %d: got %d want %d", i, sum[i], want)
+		}
+		if want := field.Sub(a[i], b[i]); diff[i] != want {
+			t.Fatalf("Sub index %d: got %d want %d", i, diff[i], want)
+		}
+	}
+}
+
+func TestNTTPackedMatchesNTT(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	for trial := 0; trial < 20; trial++ {
+		p := randPoly(r)
+
+		want := p
+		ntt.NTT((*[field.N]uint32)(&want))
+
+		pk := NTTPacked(&p)
+		got := UnpackPoly(pk)
+
+		for i := 0; i < field.N; i++ {
+			if got[i] != want[i] {
+				t.Fatalf("trial %d index %d: NTTPacked=%d want %d", trial, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestInvNTTPackedMatchesInvNTT(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	for trial := 0; trial < 20; trial++ {
+		p := randPoly(r)
+
+		want := p
+		ntt.InvNTT((*[field.N]uint32)(&want))
+
+		pk := PackPoly(&p)
+		got := InvNTTPacked(pk)
+
+		for i := 0; i < field.N; i++ {
+			if got[i] != want[i] {
+				t.Fatalf("trial %d index %d: InvNTTPacked=%d want %d", trial, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestNTTPackedInvNTTPackedRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	p := randPoly(r)
+
+	pk := NTTPacked(&p)
+	back := InvNTTPacked(pk)
+
+	for i := 0; i < field.N; i++ {
+		if back[i] != p[i] {
+			t.Fatalf("index %d: round trip got %d want %d", i, back[i], p[i])
+		}
+	}
+}
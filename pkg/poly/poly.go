@@ -40,6 +40,20 @@ func (p *Poly) InvNTT() {
 	ntt.InvNTT((*[field.N]uint32)(p))
 }
 
+// ToMont converts every coefficient to Montgomery form in place.
+func (p *Poly) ToMont() {
+	for i := 0; i < field.N; i++ {
+		p[i] = field.ToMont(p[i])
+	}
+}
+
+// FromMont converts every coefficient out of Montgomery form in place.
+func (p *Poly) FromMont() {
+	for i := 0; i < field.N; i++ {
+		p[i] = field.FromMont(p[i])
+	}
+}
+
 // MulNTT computes componentwise multiplication (for polynomials in NTT domain).
 // Inputs and output are in normal (plain) form.
 func MulNTT(a, b *Poly, result *Poly) {
@@ -156,10 +170,21 @@ func DotNTTLazy(a, b *[field.L]Poly, result *Poly) {
 // MatVecMulNTTLazy computes matrix-vector product A * v in NTT domain.
 // A is K×L matrix, v is L-element vector, result is K-element vector.
 // All inputs must be in NTT domain, normal (plain) form.
-// Uses lazy accumulation for better performance.
+//
+// Streams through VecFMA/VecReduceLazy over the whole K×L matrix as one
+// contiguous kernel (row i's N-lane accumulator is reused across all L
+// VecFMA calls, then reduced once) instead of K independent calls into a
+// hand-unrolled 4-term dot product.
 func MatVecMulNTTLazy(A *[field.K][field.L]Poly, v *[field.L]Poly, result *[field.K]Poly) {
+	var acc [field.N]uint64
 	for i := 0; i < field.K; i++ {
-		DotNTTLazy(&A[i], v, &result[i])
+		for k := range acc {
+			acc[k] = 0
+		}
+		for j := 0; j < field.L; j++ {
+			VecFMA(acc[:], A[i][j][:], v[j][:])
+		}
+		VecReduceLazy(acc[:], result[i][:])
 	}
 }
 
@@ -0,0 +1,145 @@
+package poly
+
+import "zkdilithium-signer/pkg/field"
+
+// Ring is a generic NTT-domain polynomial ring Z_Q[x]/(x^N+1), parameterized
+// over a field.Prime backend F (e.g. field.ZKDilithium or
+// pkg/field/babybear.BabyBear). It exists so the NTT/InvNTT/MulNTT/
+// SchoolbookMul algorithms pkg/ntt and this package hand-specialize for
+// field.ZKDilithium's Q can be reused, unmodified, against an alternate
+// small prime without copy-pasting the butterfly network — the concrete
+// Poly/NTT/InvNTT/MulNTT/SchoolbookMul above stay the fast, Montgomery-form
+// path every existing caller already uses; Ring is the generic one new
+// backends plug into.
+//
+// Ring builds its own zetas table from F.Params() (a fresh primitive
+// 2N-th root of unity and its inverse) rather than reusing pkg/ntt's
+// global ZetasMont, which is fixed to field.ZKDilithium's Q. Params().N
+// must be a power of two.
+type Ring[F field.Prime] struct {
+	p        F
+	n        int
+	zetas    []uint64
+	invZetas []uint64
+	inv2     uint64
+}
+
+// NewRing builds a Ring for F, precomputing its bit-reversed zetas table.
+// F must be a zero-size type (its methods are expected to be stateless;
+// see field.Prime).
+func NewRing[F field.Prime]() *Ring[F] {
+	var p F
+	params := p.Params()
+	n := params.N
+	bits := log2(n)
+
+	r := &Ring[F]{p: p, n: n, zetas: make([]uint64, n), invZetas: make([]uint64, n)}
+	for i := 0; i < n; i++ {
+		r.zetas[i] = expMod(p, params.Zeta, uint64(brv(uint64(i+1), bits)))
+	}
+	for i := 0; i < n; i++ {
+		e := uint64(n) - brv(uint64(n-1-i), bits)
+		r.invZetas[i] = expMod(p, params.InvZeta, e)
+	}
+	// inv2 = inverse of 2 mod Q.
+	r.inv2 = p.Inv(2 % params.Q)
+	return r
+}
+
+// log2 returns log base 2 of n, assuming n is a power of two.
+func log2(n int) int {
+	bits := 0
+	for (1 << bits) < n {
+		bits++
+	}
+	return bits
+}
+
+// brv reverses the low `bits` bits of x, generalizing field.Brv (fixed at
+// 8 bits) to Ring's arbitrary power-of-two N.
+func brv(x uint64, bits int) uint64 {
+	var r uint64
+	for i := 0; i < bits; i++ {
+		r = (r << 1) | (x & 1)
+		x >>= 1
+	}
+	return r
+}
+
+// expMod returns a^e mod p.Params().Q using binary exponentiation over F's
+// own Mul.
+func expMod[F field.Prime](p F, a, e uint64) uint64 {
+	result := uint64(1)
+	base := a
+	for e > 0 {
+		if e&1 == 1 {
+			result = p.Mul(result, base)
+		}
+		base = p.Mul(base, base)
+		e >>= 1
+	}
+	return result
+}
+
+// NTT computes the Number Theoretic Transform of cs in place.
+func (r *Ring[F]) NTT(cs []uint64) {
+	layer := r.n / 2
+	zi := 0
+	for layer >= 1 {
+		for offset := 0; offset < r.n-layer; offset += 2 * layer {
+			z := r.zetas[zi]
+			zi++
+			for j := offset; j < offset+layer; j++ {
+				t := r.p.Mul(z, cs[j+layer])
+				cs[j+layer] = r.p.Sub(cs[j], t)
+				cs[j] = r.p.Add(cs[j], t)
+			}
+		}
+		layer /= 2
+	}
+}
+
+// InvNTT computes the inverse Number Theoretic Transform of cs in place.
+func (r *Ring[F]) InvNTT(cs []uint64) {
+	layer := 1
+	zi := 0
+	for layer < r.n {
+		for offset := 0; offset < r.n-layer; offset += 2 * layer {
+			z := r.invZetas[zi]
+			zi++
+			for j := offset; j < offset+layer; j++ {
+				t := r.p.Sub(cs[j], cs[j+layer])
+				cs[j] = r.p.Mul(r.inv2, r.p.Add(cs[j], cs[j+layer]))
+				inv2z := r.p.Mul(r.inv2, z)
+				cs[j+layer] = r.p.Mul(inv2z, t)
+			}
+		}
+		layer *= 2
+	}
+}
+
+// MulNTT performs componentwise multiplication of two NTT-domain vectors.
+func (r *Ring[F]) MulNTT(a, b, result []uint64) {
+	for i := 0; i < r.n; i++ {
+		result[i] = r.p.Mul(a[i], b[i])
+	}
+}
+
+// SchoolbookMul computes a * b mod (x^N + 1), coefficient-reducing via F's
+// own Mul/Add/Sub rather than raw int64 accumulation — the generic
+// counterpart of the concrete SchoolbookMul above, which accumulates in
+// int64 because it knows Q^2*N fits. F's modulus may not, so each term is
+// reduced as it's added.
+func (r *Ring[F]) SchoolbookMul(a, b []uint64) []uint64 {
+	full := make([]uint64, 2*r.n)
+	for i := 0; i < r.n; i++ {
+		for j := 0; j < r.n; j++ {
+			full[i+j] = r.p.Add(full[i+j], r.p.Mul(a[i], b[j]))
+		}
+	}
+	result := make([]uint64, r.n)
+	for i := 0; i < r.n; i++ {
+		result[i] = r.p.Sub(full[i], full[i+r.n])
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package poly
+
+import (
+	"math/rand"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/field/babybear"
+	"zkdilithium-signer/pkg/field/dilithiumq"
+)
+
+// TestRingZKDilithiumMatchesConcrete checks that Ring[field.ZKDilithium]'s
+// generic NTT/InvNTT/MulNTT agree with this package's hand-specialized
+// Poly.NTT/InvNTT/MulNTT, coefficient for coefficient.
+func TestRingZKDilithiumMatchesConcrete(t *testing.T) {
+	r := NewRing[field.ZKDilithium]()
+	rnd := rand.New(rand.NewSource(1))
+
+	var a, b Poly
+	for i := range a {
+		a[i] = uint32(rnd.Intn(field.Q))
+		b[i] = uint32(rnd.Intn(field.Q))
+	}
+
+	aNTT, bNTT := a, b
+	aNTT.NTT()
+	bNTT.NTT()
+
+	var wantProd Poly
+	MulNTT(&aNTT, &bNTT, &wantProd)
+	wantProd.InvNTT()
+
+	aVec := toU64(a[:])
+	bVec := toU64(b[:])
+	r.NTT(aVec)
+	r.NTT(bVec)
+	gotProd := make([]uint64, field.N)
+	r.MulNTT(aVec, bVec, gotProd)
+	r.InvNTT(gotProd)
+
+	for i := range wantProd {
+		if uint32(gotProd[i]) != wantProd[i] {
+			t.Fatalf("Ring[ZKDilithium] product[%d] = %d, want %d", i, gotProd[i], wantProd[i])
+		}
+	}
+}
+
+func toU64(xs []uint32) []uint64 {
+	out := make([]uint64, len(xs))
+	for i, x := range xs {
+		out[i] = uint64(x)
+	}
+	return out
+}
+
+// TestRingBabyBearNTTRoundTrips exercises Ring[babybear.BabyBear] end to
+// end: NTT then InvNTT must recover the original vector, proving the
+// generic butterfly network and babybear's Prime implementation agree on
+// zeta/invZeta/inv2.
+func TestRingBabyBearNTTRoundTrips(t *testing.T) {
+	r := NewRing[babybear.BabyBear]()
+	rnd := rand.New(rand.NewSource(2))
+
+	xs := make([]uint64, babybear.N)
+	for i := range xs {
+		xs[i] = uint64(rnd.Int63n(int64(babybear.P)))
+	}
+	orig := append([]uint64(nil), xs...)
+
+	r.NTT(xs)
+	r.InvNTT(xs)
+
+	for i := range xs {
+		if xs[i] != orig[i] {
+			t.Fatalf("Ring[BabyBear] NTT/InvNTT[%d] = %d, want %d", i, xs[i], orig[i])
+		}
+	}
+}
+
+// TestRingBabyBearSchoolbookAgreesWithNTT checks SchoolbookMul and the
+// NTT-domain MulNTT path agree for a small case, the same cross-check
+// poly_test.go does for the concrete zkDilithium ring.
+func TestRingBabyBearSchoolbookAgreesWithNTT(t *testing.T) {
+	r := NewRing[babybear.BabyBear]()
+	rnd := rand.New(rand.NewSource(3))
+
+	a := make([]uint64, babybear.N)
+	b := make([]uint64, babybear.N)
+	for i := range a {
+		a[i] = uint64(rnd.Int63n(int64(babybear.P)))
+		b[i] = uint64(rnd.Int63n(int64(babybear.P)))
+	}
+
+	want := r.SchoolbookMul(a, b)
+
+	aNTT := append([]uint64(nil), a...)
+	bNTT := append([]uint64(nil), b...)
+	r.NTT(aNTT)
+	r.NTT(bNTT)
+	prod := make([]uint64, babybear.N)
+	r.MulNTT(aNTT, bNTT, prod)
+	r.InvNTT(prod)
+
+	for i := range want {
+		if prod[i] != want[i] {
+			t.Fatalf("Ring[BabyBear] NTT product[%d] = %d, want %d (schoolbook)", i, prod[i], want[i])
+		}
+	}
+}
+
+// TestRingDilithiumQNTTRoundTrips is TestRingBabyBearNTTRoundTrips for
+// field/dilithiumq.DilithiumQ, the FIPS 204 ML-DSA modulus backend: it
+// proves dilithiumq's zeta is a genuine primitive 512th root of unity mod
+// 8380417 that this package's generic butterfly network can build a
+// working negacyclic NTT from (see the package doc comment on why this
+// NTT domain is not wire-compatible with the standard's own).
+func TestRingDilithiumQNTTRoundTrips(t *testing.T) {
+	r := NewRing[dilithiumq.DilithiumQ]()
+	rnd := rand.New(rand.NewSource(4))
+
+	xs := make([]uint64, dilithiumq.N)
+	for i := range xs {
+		xs[i] = uint64(rnd.Int63n(int64(dilithiumq.Q)))
+	}
+	orig := append([]uint64(nil), xs...)
+
+	r.NTT(xs)
+	r.InvNTT(xs)
+
+	for i := range xs {
+		if xs[i] != orig[i] {
+			t.Fatalf("Ring[DilithiumQ] NTT/InvNTT[%d] = %d, want %d", i, xs[i], orig[i])
+		}
+	}
+}
+
+// TestRingDilithiumQSchoolbookAgreesWithNTT is
+// TestRingBabyBearSchoolbookAgreesWithNTT for dilithiumq.DilithiumQ.
+func TestRingDilithiumQSchoolbookAgreesWithNTT(t *testing.T) {
+	r := NewRing[dilithiumq.DilithiumQ]()
+	rnd := rand.New(rand.NewSource(5))
+
+	a := make([]uint64, dilithiumq.N)
+	b := make([]uint64, dilithiumq.N)
+	for i := range a {
+		a[i] = uint64(rnd.Int63n(int64(dilithiumq.Q)))
+		b[i] = uint64(rnd.Int63n(int64(dilithiumq.Q)))
+	}
+
+	want := r.SchoolbookMul(a, b)
+
+	aNTT := append([]uint64(nil), a...)
+	bNTT := append([]uint64(nil), b...)
+	r.NTT(aNTT)
+	r.NTT(bNTT)
+	prod := make([]uint64, dilithiumq.N)
+	r.MulNTT(aNTT, bNTT, prod)
+	r.InvNTT(prod)
+
+	for i := range want {
+		if prod[i] != want[i] {
+			t.Fatalf("Ring[DilithiumQ] NTT product[%d] = %d, want %d (schoolbook)", i, prod[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,7 @@
+// Package simd is the poly-level counterpart to pkg/field/simd: NTT,
+// InvNTT and MulNTT for a whole Poly. All three simply call the pkg/ntt /
+// pkg/poly scalar implementations, unconditionally — no AVX2/NEON
+// butterfly kernel exists in this package, and Detected() is not
+// consulted. See pkg/field/simd/doc.go for the equivalent field-level
+// scaffolding.
+package simd
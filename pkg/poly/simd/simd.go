@@ -0,0 +1,39 @@
+package simd
+
+import (
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/poly"
+)
+
+// NTT computes NTT in place by calling poly.Poly.NTT.
+func NTT(p *poly.Poly) {
+	p.NTT()
+}
+
+// InvNTT computes inverse NTT in place.
+func InvNTT(p *poly.Poly) {
+	p.InvNTT()
+}
+
+// MulNTT computes componentwise multiplication of two NTT-domain
+// polynomials, writing the result into result.
+func MulNTT(a, b, result *poly.Poly) {
+	poly.MulNTT(a, b, result)
+}
+
+// Add computes a + b componentwise.
+func Add(a, b, result *poly.Poly) {
+	poly.Add(a, b, result)
+}
+
+// Sub computes a - b componentwise.
+func Sub(a, b, result *poly.Poly) {
+	poly.Sub(a, b, result)
+}
+
+// BatchInvMont inverts every coefficient of p in place, in Montgomery
+// form, using field.BatchInvMont with freshly allocated scratch.
+func BatchInvMont(p *poly.Poly) {
+	var scratch [field.N]uint32
+	field.BatchInvMont(p[:], scratch[:])
+}
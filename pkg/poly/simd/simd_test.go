@@ -0,0 +1,57 @@
+package simd
+
+import (
+	"math/rand"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/poly"
+)
+
+func randPoly(r *rand.Rand) poly.Poly {
+	var p poly.Poly
+	for i := range p {
+		p[i] = uint32(r.Intn(field.Q))
+	}
+	return p
+}
+
+func TestMulNTTAgainstSchoolbook(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	a, b := randPoly(r), randPoly(r)
+
+	aNTT, bNTT := a, b
+	NTT(&aNTT)
+	NTT(&bNTT)
+
+	var prodNTT poly.Poly
+	MulNTT(&aNTT, &bNTT, &prodNTT)
+	InvNTT(&prodNTT)
+
+	_, want := poly.SchoolbookMul(&a, &b)
+	if prodNTT != want {
+		t.Fatalf("NTT-domain product disagrees with SchoolbookMul remainder")
+	}
+}
+
+func TestAddSubRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a, b := randPoly(r), randPoly(r)
+
+	var sum, back poly.Poly
+	Add(&a, &b, &sum)
+	Sub(&sum, &b, &back)
+	if back != a {
+		t.Fatalf("Add then Sub did not round-trip")
+	}
+}
+
+func BenchmarkNTT(b *testing.B) {
+	r := rand.New(rand.NewSource(3))
+	p := randPoly(r)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		q := p
+		NTT(&q)
+	}
+}
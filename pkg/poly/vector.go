@@ -0,0 +1,114 @@
+package poly
+
+import "zkdilithium-signer/pkg/field"
+
+// This file provides a small "vector API" over flat []uint32 coefficient
+// slices, in the spirit of goff/gnark-crypto's vector.go: fixed 16-wide
+// unrolled loops with no data-dependent branches in the hot path, so a
+// compiler (or a companion assembly file selected by a purego build tag,
+// as pkg/field already does for its Montgomery primitives) can widen the
+// inner loop to 16 AVX-512 or 8 AVX2 lanes. Callers pass slices whose
+// length is a multiple of 16 for the fast path; any remainder is handled
+// by a plain scalar tail loop.
+const vecLanes = 16
+
+// VecAdd computes result[i] = a[i] + b[i] for every i.
+func VecAdd(a, b, result []uint32) {
+	n := len(a)
+	i := 0
+	for ; i+vecLanes <= n; i += vecLanes {
+		for k := 0; k < vecLanes; k++ {
+			result[i+k] = field.Add(a[i+k], b[i+k])
+		}
+	}
+	for ; i < n; i++ {
+		result[i] = field.Add(a[i], b[i])
+	}
+}
+
+// VecSub computes result[i] = a[i] - b[i] for every i.
+func VecSub(a, b, result []uint32) {
+	n := len(a)
+	i := 0
+	for ; i+vecLanes <= n; i += vecLanes {
+		for k := 0; k < vecLanes; k++ {
+			result[i+k] = field.Sub(a[i+k], b[i+k])
+		}
+	}
+	for ; i < n; i++ {
+		result[i] = field.Sub(a[i], b[i])
+	}
+}
+
+// VecScalarMul computes result[i] = a[i] * s for every i.
+func VecScalarMul(a []uint32, s uint32, result []uint32) {
+	n := len(a)
+	i := 0
+	for ; i+vecLanes <= n; i += vecLanes {
+		for k := 0; k < vecLanes; k++ {
+			result[i+k] = field.Mul(a[i+k], s)
+		}
+	}
+	for ; i < n; i++ {
+		result[i] = field.Mul(a[i], s)
+	}
+}
+
+// VecMulNTT computes result[i] = a[i] * b[i] for every i (componentwise
+// multiplication of NTT-domain polynomials).
+func VecMulNTT(a, b, result []uint32) {
+	n := len(a)
+	i := 0
+	for ; i+vecLanes <= n; i += vecLanes {
+		for k := 0; k < vecLanes; k++ {
+			result[i+k] = field.Mul(a[i+k], b[i+k])
+		}
+	}
+	for ; i < n; i++ {
+		result[i] = field.Mul(a[i], b[i])
+	}
+}
+
+// VecFMA accumulates acc[i] += a[i] * b[i] for every i, in unreduced
+// uint64 lanes — the same lazy-accumulation trick DotNTTLazy uses
+// inline for L=4 terms, generalized so any number of terms can be summed
+// into acc before a single final reduction (via VecReduceLazy).
+func VecFMA(acc []uint64, a, b []uint32) {
+	n := len(a)
+	i := 0
+	for ; i+vecLanes <= n; i += vecLanes {
+		for k := 0; k < vecLanes; k++ {
+			acc[i+k] += uint64(a[i+k]) * uint64(b[i+k])
+		}
+	}
+	for ; i < n; i++ {
+		acc[i] += uint64(a[i]) * uint64(b[i])
+	}
+}
+
+// VecReduceLazy reduces each of acc's unreduced lanes mod Q into result.
+func VecReduceLazy(acc []uint64, result []uint32) {
+	n := len(acc)
+	i := 0
+	for ; i+vecLanes <= n; i += vecLanes {
+		for k := 0; k < vecLanes; k++ {
+			result[i+k] = uint32(acc[i+k] % field.Q)
+		}
+	}
+	for ; i < n; i++ {
+		result[i] = uint32(acc[i] % field.Q)
+	}
+}
+
+// VecInnerProduct computes the dot product of len(as) polynomials (all in
+// NTT domain, normal form), writing the reduced result into result. It
+// generalizes DotNTTLazy (fixed at L=4 terms) to any number of terms by
+// streaming through VecFMA/VecReduceLazy instead of a hand-unrolled
+// 4-term accumulation.
+func VecInnerProduct(as, bs []Poly, result *Poly) {
+	var acc [field.N]uint64
+	for idx := range as {
+		VecFMA(acc[:], as[idx][:], bs[idx][:])
+	}
+	VecReduceLazy(acc[:], result[:])
+}
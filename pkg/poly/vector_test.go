@@ -0,0 +1,118 @@
+package poly
+
+import (
+	"math/rand"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+)
+
+func randSlice(r *rand.Rand, n int) []uint32 {
+	s := make([]uint32, n)
+	for i := range s {
+		s[i] = uint32(r.Int63n(field.Q))
+	}
+	return s
+}
+
+func TestVecAddSubMatchScalar(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 7, 16, 17, 35, 256} {
+		a := randSlice(r, n)
+		b := randSlice(r, n)
+
+		gotAdd := make([]uint32, n)
+		gotSub := make([]uint32, n)
+		VecAdd(a, b, gotAdd)
+		VecSub(a, b, gotSub)
+
+		for i := 0; i < n; i++ {
+			if want := field.Add(a[i], b[i]); gotAdd[i] != want {
+				t.Fatalf("n=%d VecAdd[%d] = %d, want %d", n, i, gotAdd[i], want)
+			}
+			if want := field.Sub(a[i], b[i]); gotSub[i] != want {
+				t.Fatalf("n=%d VecSub[%d] = %d, want %d", n, i, gotSub[i], want)
+			}
+		}
+	}
+}
+
+func TestVecScalarMulAndMulNTT(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	n := 40
+	a := randSlice(r, n)
+	b := randSlice(r, n)
+	s := uint32(r.Int63n(field.Q))
+
+	gotScalar := make([]uint32, n)
+	VecScalarMul(a, s, gotScalar)
+	gotMul := make([]uint32, n)
+	VecMulNTT(a, b, gotMul)
+
+	for i := 0; i < n; i++ {
+		if want := field.Mul(a[i], s); gotScalar[i] != want {
+			t.Fatalf("VecScalarMul[%d] = %d, want %d", i, gotScalar[i], want)
+		}
+		if want := field.Mul(a[i], b[i]); gotMul[i] != want {
+			t.Fatalf("VecMulNTT[%d] = %d, want %d", i, gotMul[i], want)
+		}
+	}
+}
+
+func TestVecFMAAndInnerProduct(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	const terms = 5
+	as := make([]Poly, terms)
+	bs := make([]Poly, terms)
+	for term := 0; term < terms; term++ {
+		for i := 0; i < field.N; i++ {
+			as[term][i] = uint32(r.Int63n(field.Q))
+			bs[term][i] = uint32(r.Int63n(field.Q))
+		}
+	}
+
+	var result Poly
+	VecInnerProduct(as, bs, &result)
+
+	for i := 0; i < field.N; i++ {
+		var acc uint64
+		for term := 0; term < terms; term++ {
+			acc += uint64(as[term][i]) * uint64(bs[term][i])
+		}
+		if want := uint32(acc % field.Q); result[i] != want {
+			t.Fatalf("index %d: VecInnerProduct = %d, want %d", i, result[i], want)
+		}
+	}
+}
+
+func TestMatVecMulNTTLazyMatchesDotNTTLazy(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	var A [field.K][field.L]Poly
+	var v [field.L]Poly
+	for i := 0; i < field.K; i++ {
+		for j := 0; j < field.L; j++ {
+			for k := 0; k < field.N; k++ {
+				A[i][j][k] = uint32(r.Int63n(field.Q))
+			}
+		}
+	}
+	for j := 0; j < field.L; j++ {
+		for k := 0; k < field.N; k++ {
+			v[j][k] = uint32(r.Int63n(field.Q))
+		}
+	}
+
+	var want [field.K]Poly
+	for i := 0; i < field.K; i++ {
+		DotNTTLazy(&A[i], &v, &want[i])
+	}
+
+	var got [field.K]Poly
+	MatVecMulNTTLazy(&A, &v, &got)
+
+	for i := 0; i < field.K; i++ {
+		if !Equal(&want[i], &got[i]) {
+			t.Fatalf("row %d: MatVecMulNTTLazy does not match DotNTTLazy", i)
+		}
+	}
+}
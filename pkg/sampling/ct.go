@@ -0,0 +1,179 @@
+package sampling
+
+import (
+	"errors"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/hash"
+	"zkdilithium-signer/pkg/poly"
+)
+
+// ErrSampleExhausted is returned by the *CT samplers below when their fixed
+// candidate budget ran out before field.N coefficients were accepted.
+// Unlike SampleUniform/SampleLeqEta's "stream too short" panic, this is a
+// normal error a secret-path caller can act on — retry with a fresh
+// nonce/seed — rather than a crash. From SampleUniformCT and SampleLeqEtaCT
+// it should never fire in practice: each budget below is sized so far past
+// the expected candidate count that coming up short has negligible
+// probability. SampleInBallCT also returns it whenever its sample is
+// rejected, which happens as often as SampleInBall returns nil.
+var ErrSampleExhausted = errors.New("sampling: exhausted fixed candidate budget without field.N acceptances")
+
+// eqMask32 returns 0xFFFFFFFF if a == b, else 0. Same technique as
+// field.eqMask32 (crypto/subtle.ConstantTimeEq widened to a full mask).
+func eqMask32(a, b uint32) uint32 {
+	diff := uint64(a ^ b)
+	bit := uint32((diff - 1) >> 63) // 1 if a==b, 0 otherwise
+	return -bit
+}
+
+// ltMask32 returns all-ones if i < n, all-zero otherwise.
+func ltMask32(i, n int) uint32 {
+	return uint32(int32(int32(i)-int32(n)) >> 31)
+}
+
+// selectU32 is the constant-time multiplexer `select(mask, a, b) = b ^
+// ((a^b) & mask)`, same as field.selectU32: a if mask is all-ones, b if
+// mask is zero.
+func selectU32(mask, a, b uint32) uint32 {
+	return b ^ ((a ^ b) & mask)
+}
+
+// uniformCTCandidates is the fixed number of 3-byte candidates
+// SampleUniformCT reads from xof, independent of how many it accepts. Each
+// candidate is accepted with probability field.Q/2^23 ~ 0.875, so filling
+// field.N slots takes ~293 candidates on average; at 3*field.N the odds of
+// coming up short are astronomically small.
+const uniformCTCandidates = 3 * field.N
+
+// SampleUniformCT is SampleUniformStreaming's constant-time counterpart: it
+// always reads exactly uniformCTCandidates candidates from xof and writes
+// each accepted one into cs through a masked, branchless running index
+// (i += mask&1) instead of the `if d < field.Q { ... }` branch. On the same
+// input bytes it produces the identical polynomial SampleUniformStreaming
+// would, just without letting the instruction trace reveal how many
+// candidates were rejected along the way. If fewer than field.N candidates
+// are accepted within the fixed budget, it returns ErrSampleExhausted
+// instead of looping forever or panicking.
+func SampleUniformCT(xof *hash.StreamingXOF128) (poly.Poly, error) {
+	var cs poly.Poly
+	i := 0
+	for n := 0; n < uniformCTCandidates; n++ {
+		b0, b1, b2 := xof.Read3()
+		d := (uint32(b0) + (uint32(b1) << 8) + (uint32(b2) << 16)) & 0x7FFFFF
+
+		accept := uint32(int32(d-field.Q) >> 31) // all-ones iff d < field.Q
+		room := ltMask32(i, field.N)             // all-ones iff a slot remains
+		take := accept & room
+
+		idx := i & (field.N - 1) // field.N is a power of two: always in range
+		cs[idx] = selectU32(take, d, cs[idx])
+		i += int(take & 1)
+	}
+	if i < field.N {
+		return poly.Poly{}, ErrSampleExhausted
+	}
+	return cs, nil
+}
+
+// leqEtaCTBlocks is the fixed number of 3-byte (six-nibble) blocks
+// SampleLeqEtaCT reads. Each nibble is accepted with probability 15/16, so
+// six per block gives an expected ~46 blocks to fill field.N slots;
+// field.N blocks leaves an enormous margin.
+const leqEtaCTBlocks = field.N
+
+// SampleLeqEtaCT is SampleLeqEtaStreaming's constant-time counterpart: it
+// always reads leqEtaCTBlocks three-byte blocks (six nibble candidates
+// each) from xof, replacing both the `if d <= 14 { ... }` accept branch and
+// the early `break` once field.N slots are full with mask arithmetic, so
+// the number of blocks read and nibbles examined never depends on the
+// stream's content. On the same input bytes it produces the identical
+// polynomial SampleLeqEtaStreaming would.
+func SampleLeqEtaCT(xof *hash.StreamingXOF256) (poly.Poly, error) {
+	var cs poly.Poly
+	i := 0
+	for n := 0; n < leqEtaCTBlocks; n++ {
+		b0, b1, b2 := xof.Read3()
+		ds := [6]uint8{
+			b0 & 15, b0 >> 4,
+			b1 & 15, b1 >> 4,
+			b2 & 15, b2 >> 4,
+		}
+		for _, d := range ds {
+			accept := uint32(int32(int32(d)-15) >> 31) // all-ones iff d <= 14
+			room := ltMask32(i, field.N)
+			take := accept & room
+
+			val := field.Mod(int64(2 - int(d%5)))
+			idx := i & (field.N - 1)
+			cs[idx] = selectU32(take, val, cs[idx])
+			i += int(take & 1)
+		}
+	}
+	if i < field.N {
+		return poly.Poly{}, ErrSampleExhausted
+	}
+	return cs, nil
+}
+
+// SampleInBallCT is SampleInBall's constant-time counterpart. SampleInBall
+// has two `if q == field.Q/divisor { return nil }` early exits that leak
+// not just a data-dependent branch but a data-dependent *number of
+// h.ApplyPerm calls* — an observer able to time the call learns roughly
+// which cycle triggered rejection. SampleInBallCT always runs all
+// numCycles permutation applies and only decides whether the sample is
+// rejected after the last one, folding both exit conditions into a single
+// `rejected` mask via eqMask32 instead of returning early.
+//
+// It returns an error rather than nil on rejection so a secret-path caller
+// has something to act on; dilithium.Sign's existing `if c == nil {
+// continue }` retry loop can be pointed at this once it needs to be
+// constant-time end to end, but is left calling SampleInBall for now since
+// its golden-vector tests are pinned to SampleInBall's exact retry timing.
+func SampleInBallCT(h *hash.Poseidon) (*poly.Poly, error) {
+	var ret poly.Poly
+	signsPerFe := uint32(8)
+	nTau := ((field.Tau + field.PosCycleLen - 1) / field.PosCycleLen) * field.PosCycleLen
+	numCycles := (field.Tau + field.PosCycleLen - 1) / field.PosCycleLen
+
+	rejected := uint32(0)
+
+	for i := 0; i < numCycles; i++ {
+		h.ApplyPerm()
+		state := h.State()
+
+		// h.State() is in Montgomery form (see Poseidon.State's doc
+		// comment); convert to plain form first, matching SampleInBall.
+		fe := field.FromMont(state[8])
+		q := fe / (1 << signsPerFe)
+		r := fe % (1 << signsPerFe)
+		rejected |= eqMask32(q, field.Q/(1<<signsPerFe))
+
+		var signs [8]uint32
+		for j := 0; j < field.PosCycleLen; j++ {
+			if r&1 == 0 {
+				signs[j] = 1
+			} else {
+				signs[j] = field.Q - 1
+			}
+			r >>= 1
+		}
+
+		for j := 0; j < field.PosCycleLen; j++ {
+			base := 256 - nTau + i*field.PosCycleLen + j
+			fe := field.FromMont(state[j])
+			divisor := uint32(base + 1)
+			q := fe / divisor
+			swapR := int(fe % divisor)
+			rejected |= eqMask32(q, field.Q/divisor)
+
+			ret[base] = ret[swapR]
+			ret[swapR] = signs[j]
+		}
+	}
+
+	if rejected != 0 {
+		return nil, ErrSampleExhausted
+	}
+	return &ret, nil
+}
@@ -0,0 +1,105 @@
+package sampling
+
+import (
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/hash"
+)
+
+// TestSampleUniformCTMatchesStreaming checks that, given identical input
+// bytes, SampleUniformCT's masked accept/write path produces the exact
+// same polynomial as SampleUniformStreaming's branching one.
+func TestSampleUniformCTMatchesStreaming(t *testing.T) {
+	for nonce := uint16(0); nonce < 8; nonce++ {
+		seed := make([]byte, 32)
+		seed[0] = byte(nonce)
+
+		want := SampleUniformStreaming(hash.NewStreamingXOF128(seed, nonce))
+		got, err := SampleUniformCT(hash.NewStreamingXOF128(seed, nonce))
+		if err != nil {
+			t.Fatalf("nonce=%d: SampleUniformCT returned %v", nonce, err)
+		}
+		if got != want {
+			t.Fatalf("nonce=%d: SampleUniformCT != SampleUniformStreaming\ngot:  %v\nwant: %v", nonce, got, want)
+		}
+	}
+}
+
+// TestSampleLeqEtaCTMatchesStreaming is SampleUniformCT's test above,
+// against SampleLeqEtaStreaming/SampleLeqEtaCT.
+func TestSampleLeqEtaCTMatchesStreaming(t *testing.T) {
+	for nonce := uint16(0); nonce < 8; nonce++ {
+		seed := make([]byte, 32)
+		seed[0] = byte(nonce + 1)
+
+		xofWant := hash.NewStreamingXOF256Reusable()
+		xofWant.Reset(seed, nonce)
+		want := SampleLeqEtaStreaming(xofWant)
+
+		xofGot := hash.NewStreamingXOF256Reusable()
+		xofGot.Reset(seed, nonce)
+		got, err := SampleLeqEtaCT(xofGot)
+		if err != nil {
+			t.Fatalf("nonce=%d: SampleLeqEtaCT returned %v", nonce, err)
+		}
+		if got != want {
+			t.Fatalf("nonce=%d: SampleLeqEtaCT != SampleLeqEtaStreaming\ngot:  %v\nwant: %v", nonce, got, want)
+		}
+	}
+}
+
+// TestSampleInBallCTMatchesSampleInBall checks agreement on both the
+// accept and (much rarer) reject path. A reject comes up in roughly one
+// transcript in 1800, so the 200 here need not include one; only the
+// accept path is required to occur.
+func TestSampleInBallCTMatchesSampleInBall(t *testing.T) {
+	rejects, accepts := 0, 0
+	for nonce := 0; nonce < 200; nonce++ {
+		seed := []uint32{2, uint32(nonce)}
+
+		want := SampleInBall(hash.NewPoseidon(seed))
+		got, err := SampleInBallCT(hash.NewPoseidon(seed))
+
+		if want == nil {
+			rejects++
+			if err == nil {
+				t.Fatalf("nonce=%d: SampleInBall rejected but SampleInBallCT accepted %v", nonce, *got)
+			}
+			continue
+		}
+
+		accepts++
+		if err != nil {
+			t.Fatalf("nonce=%d: SampleInBall accepted %v but SampleInBallCT rejected: %v", nonce, *want, err)
+		}
+		if *got != *want {
+			t.Fatalf("nonce=%d: SampleInBallCT != SampleInBall\ngot:  %v\nwant: %v", nonce, *got, *want)
+		}
+	}
+	if accepts == 0 {
+		t.Fatalf("no accepted samples in range, test is vacuous")
+	}
+}
+
+// TestSampleSecretRoutesThroughCT is a smoke test that SampleSecret (now
+// backed by SampleLeqEtaCT) still returns without panicking and produces
+// coefficients in [-field.Eta, field.Eta] mod field.Q, same as before.
+func TestSampleSecretRoutesThroughCT(t *testing.T) {
+	s1, s2 := SampleSecret(make([]byte, 64))
+	check := func(name string, c uint32) {
+		if c > field.Eta && c < field.Q-field.Eta {
+			t.Fatalf("%s coefficient %d out of [-Eta, Eta]", name, c)
+		}
+	}
+	for _, p := range s1 {
+		for _, c := range p {
+			check("s1", c)
+		}
+	}
+	for _, p := range s2 {
+		for _, c := range p {
+			check("s2", c)
+		}
+	}
+}
@@ -130,21 +130,91 @@ func SampleMatrix(rho []byte) [field.K][field.L]poly.Poly {
 	return A
 }
 
-// SampleSecret samples secret vectors s1, s2 from seed rho.
+// SampleUniformX4 samples four uniform polynomials at once from the four
+// lanes of xof, which share a single seed absorption.
+func SampleUniformX4(xof *hash.SeedClonableXOF128x4) [4]poly.Poly {
+	var out [4]poly.Poly
+	for lane := 0; lane < 4; lane++ {
+		var cs poly.Poly
+		i := 0
+		for i < field.N {
+			b0, b1, b2 := xof.Read3(lane)
+			d := (uint32(b0) + (uint32(b1) << 8) + (uint32(b2) << 16)) & 0x7FFFFF
+			if d < field.Q {
+				cs[i] = d
+				i++
+			}
+		}
+		out[lane] = cs
+	}
+	return out
+}
+
+// SampleMatrixClonableX4 samples the public matrix A from seed rho like
+// SampleMatrix, but expands it four nonces at a time through
+// hash.SeedClonableXOF128x4 instead of resetting one streaming XOF per
+// (i, j) entry. field.K*field.L must be a multiple of 4.
+func SampleMatrixClonableX4(rho []byte) [field.K][field.L]poly.Poly {
+	var A [field.K][field.L]poly.Poly
+	xof := hash.NewSeedClonableXOF128x4(rho)
+
+	type coord struct{ i, j int }
+	var coords []coord
+	for i := 0; i < field.K; i++ {
+		for j := 0; j < field.L; j++ {
+			coords = append(coords, coord{i, j})
+		}
+	}
+
+	for base := 0; base+4 <= len(coords); base += 4 {
+		var nonces [4]uint16
+		for k := 0; k < 4; k++ {
+			c := coords[base+k]
+			nonces[k] = uint16(256*c.i + c.j)
+		}
+		xof.SetNonces(nonces)
+		polys := SampleUniformX4(xof)
+		for k := 0; k < 4; k++ {
+			c := coords[base+k]
+			A[c.i][c.j] = polys[k]
+		}
+	}
+	return A
+}
+
+// SampleSecret samples secret vectors s1, s2 from seed rho. Both loops
+// route through SampleLeqEtaCT rather than SampleLeqEtaStreaming, since s1
+// and s2 are exactly the long-term secret key material the CT hardening in
+// ct.go exists to protect. ErrSampleExhausted has negligible probability
+// (see SampleLeqEtaCT's doc comment); it's treated as an unreachable
+// invariant violation, matching the "stream too short" panic the non-CT
+// samplers already use for their own unreachable conditions.
 func SampleSecret(rho []byte) (s1 [field.L]poly.Poly, s2 [field.K]poly.Poly) {
 	xof := hash.NewStreamingXOF256Reusable()
 	for i := 0; i < field.L; i++ {
 		xof.Reset(rho, uint16(i))
-		s1[i] = SampleLeqEtaStreaming(xof)
+		p, err := SampleLeqEtaCT(xof)
+		if err != nil {
+			panic(err)
+		}
+		s1[i] = p
 	}
 	for i := 0; i < field.K; i++ {
 		xof.Reset(rho, uint16(field.L+i))
-		s2[i] = SampleLeqEtaStreaming(xof)
+		p, err := SampleLeqEtaCT(xof)
+		if err != nil {
+			panic(err)
+		}
+		s2[i] = p
 	}
 	return
 }
 
-// SampleY samples the masking vector y from rho and nonce.
+// SampleY samples the masking vector y from rho and nonce. Unlike
+// SampleSecret, this has nothing to route through SampleUniformCT/
+// SampleLeqEtaCT: encoding.UnpackPolyLeGamma1 is a direct bit-unpack with
+// no rejection sampling, so it was already constant-time in the sense
+// ct.go hardens for.
 func SampleY(rho []byte, nonce int) [field.L]poly.Poly {
 	var y [field.L]poly.Poly
 	// Build input buffer explicitly to avoid aliasing if rho has spare capacity
@@ -173,8 +243,9 @@ func SampleInBall(h *hash.Poseidon) *poly.Poly {
 		h.ApplyPerm()
 		state := h.State()
 
-		// Read signs from state[8] (plain field form)
-		fe := state[8]
+		// Read signs from state[8]. h.State() is in Montgomery form (see
+		// Poseidon.State's doc comment); convert to plain form first.
+		fe := field.FromMont(state[8])
 		q := fe / (1 << signsPerFe)
 		r := fe % (1 << signsPerFe)
 		if q == field.Q/(1<<signsPerFe) {
@@ -191,10 +262,11 @@ func SampleInBall(h *hash.Poseidon) *poly.Poly {
 			r >>= 1
 		}
 
-		// Read swap positions from state[0:8] (plain field form)
+		// Read swap positions from state[0:8], again converting out of
+		// Montgomery form first.
 		for j := 0; j < field.PosCycleLen; j++ {
 			base := 256 - nTau + i*field.PosCycleLen + j
-			fe := state[j]
+			fe := field.FromMont(state[j])
 			divisor := uint32(base + 1)
 			q := fe / divisor
 			swapR := int(fe % divisor)
@@ -0,0 +1,71 @@
+package sampling
+
+import (
+	"sync"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/hash"
+	"zkdilithium-signer/pkg/poly"
+)
+
+// SampleMatrixInto samples the public matrix A from seed rho into a
+// caller-provided array, avoiding the value-copy SampleMatrix's return
+// forces on every call site. It is otherwise identical to SampleMatrix.
+func SampleMatrixInto(rho []byte, A *[field.K][field.L]poly.Poly) {
+	xof := hash.NewStreamingXOF128Reusable()
+	for i := 0; i < field.K; i++ {
+		for j := 0; j < field.L; j++ {
+			xof.Reset(rho, uint16(256*i+j))
+			A[i][j] = SampleUniformStreaming(xof)
+		}
+	}
+}
+
+// SampleMatrixParallel samples the public matrix A from seed rho like
+// SampleMatrix, but spreads the K*L cells across workers goroutines. Each
+// worker clones a shared hash.SeedClonableXOF128 seeded once with rho, so
+// the workers pay for the SHAKE-128 seed absorption exactly once between
+// them instead of once per cell. workers <= 1 samples on the calling
+// goroutine with no cloning or synchronization overhead.
+func SampleMatrixParallel(rho []byte, workers int) [field.K][field.L]poly.Poly {
+	var A [field.K][field.L]poly.Poly
+	SampleMatrixParallelInto(rho, workers, &A)
+	return A
+}
+
+// SampleMatrixParallelInto is SampleMatrixParallel writing into a
+// caller-provided array, mirroring SampleMatrixInto.
+func SampleMatrixParallelInto(rho []byte, workers int, A *[field.K][field.L]poly.Poly) {
+	const cells = field.K * field.L
+	if workers <= 1 || cells <= workers {
+		base := hash.NewSeedClonableXOF128(rho)
+		for i := 0; i < field.K; i++ {
+			for j := 0; j < field.L; j++ {
+				base.SetNonce(uint16(256*i + j))
+				A[i][j] = SampleUniformClonable(base)
+			}
+		}
+		return
+	}
+
+	base := hash.NewSeedClonableXOF128(rho)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		lo := w * cells / workers
+		hi := (w + 1) * cells / workers
+		if lo == hi {
+			continue
+		}
+		wg.Add(1)
+		go func(lo, hi int) {
+			defer wg.Done()
+			xof := base.Clone()
+			for cell := lo; cell < hi; cell++ {
+				i, j := cell/field.L, cell%field.L
+				xof.SetNonce(uint16(256*i + j))
+				A[i][j] = SampleUniformClonable(xof)
+			}
+		}(lo, hi)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,49 @@
+package sampling
+
+import (
+	"fmt"
+	"testing"
+
+	"zkdilithium-signer/pkg/field"
+	"zkdilithium-signer/pkg/poly"
+)
+
+func TestSampleMatrixParallelMatchesSampleMatrix(t *testing.T) {
+	rho := make([]byte, 32)
+	for i := range rho {
+		rho[i] = byte(3 * i)
+	}
+
+	want := SampleMatrix(rho)
+	for _, workers := range []int{1, 2, 3, 4, 8} {
+		got := SampleMatrixParallel(rho, workers)
+		if got != want {
+			t.Fatalf("SampleMatrixParallel(workers=%d) does not match SampleMatrix", workers)
+		}
+	}
+}
+
+func TestSampleMatrixIntoMatchesSampleMatrix(t *testing.T) {
+	rho := make([]byte, 32)
+	for i := range rho {
+		rho[i] = byte(5 * i)
+	}
+
+	want := SampleMatrix(rho)
+	var got [field.K][field.L]poly.Poly
+	SampleMatrixInto(rho, &got)
+	if got != want {
+		t.Fatal("SampleMatrixInto does not match SampleMatrix")
+	}
+}
+
+func BenchmarkSampleMatrixParallel(b *testing.B) {
+	rho := make([]byte, 32)
+	for _, workers := range []int{2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = SampleMatrixParallel(rho, workers)
+			}
+		})
+	}
+}
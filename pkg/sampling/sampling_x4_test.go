@@ -0,0 +1,55 @@
+package sampling
+
+import (
+	"testing"
+
+	"zkdilithium-signer/pkg/hash"
+)
+
+func TestSampleUniformX4MatchesSampleUniformClonable(t *testing.T) {
+	rho := make([]byte, 32)
+	for i := range rho {
+		rho[i] = byte(i)
+	}
+	nonces := [4]uint16{7, 8, 9, 10}
+
+	x4 := hash.NewSeedClonableXOF128x4(rho)
+	x4.SetNonces(nonces)
+	got := SampleUniformX4(x4)
+
+	base := hash.NewSeedClonableXOF128(rho)
+	for lane, nonce := range nonces {
+		base.SetNonce(nonce)
+		want := SampleUniformClonable(base)
+		if got[lane] != want {
+			t.Fatalf("lane %d: SampleUniformX4 = %v, want %v", lane, got[lane], want)
+		}
+	}
+}
+
+func TestSampleMatrixClonableX4MatchesSampleMatrix(t *testing.T) {
+	rho := make([]byte, 32)
+	for i := range rho {
+		rho[i] = byte(2 * i)
+	}
+
+	want := SampleMatrix(rho)
+	got := SampleMatrixClonableX4(rho)
+	if got != want {
+		t.Fatal("SampleMatrixClonableX4 does not match SampleMatrix")
+	}
+}
+
+func BenchmarkSampleMatrix(b *testing.B) {
+	rho := make([]byte, 32)
+	for i := 0; i < b.N; i++ {
+		_ = SampleMatrix(rho)
+	}
+}
+
+func BenchmarkSampleMatrixClonableX4(b *testing.B) {
+	rho := make([]byte, 32)
+	for i := 0; i < b.N; i++ {
+		_ = SampleMatrixClonableX4(rho)
+	}
+}
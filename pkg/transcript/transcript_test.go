@@ -0,0 +1,89 @@
+package transcript
+
+import "testing"
+
+func TestTranscriptDeterministic(t *testing.T) {
+	build := func() []byte {
+		tr := NewTranscript("zkdilithium-signer/test")
+		if err := tr.Bind("pk", []byte("public-key-bytes")); err != nil {
+			t.Fatalf("Bind: %v", err)
+		}
+		tr.Append("msg", []byte("hello "))
+		tr.Append("msg", []byte("world"))
+		return tr.Challenge("c", 32)
+	}
+
+	a, b := build(), build()
+	if string(a) != string(b) {
+		t.Fatalf("transcript is not deterministic: %x != %x", a, b)
+	}
+}
+
+func TestTranscriptLabelDomainSeparates(t *testing.T) {
+	run := func(label string) []byte {
+		tr := NewTranscript(label)
+		tr.Append("msg", []byte("same bytes"))
+		return tr.Challenge("c", 32)
+	}
+
+	if string(run("protocol-a")) == string(run("protocol-b")) {
+		t.Fatal("different labels produced the same challenge")
+	}
+}
+
+func TestTranscriptBindRejectsDuplicateName(t *testing.T) {
+	tr := NewTranscript("dup")
+	if err := tr.Bind("pk", []byte("1")); err != nil {
+		t.Fatalf("first Bind: %v", err)
+	}
+	if err := tr.Bind("pk", []byte("2")); err == nil {
+		t.Fatal("expected error rebinding an already-bound name")
+	}
+}
+
+func TestTranscriptAppendAllowsRepeats(t *testing.T) {
+	tr := NewTranscript("append")
+	tr.Append("chunk", []byte("a"))
+	tr.Append("chunk", []byte("b"))
+	// Should not panic and should still produce output.
+	if len(tr.Challenge("c", 16)) != 16 {
+		t.Fatal("unexpected challenge length")
+	}
+}
+
+func TestTranscriptFramingDistinguishesBoundaries(t *testing.T) {
+	// ("ab", "cd") must not collide with ("a", "bcd") even though the
+	// concatenated bytes are identical, proving the length framing (not
+	// just concatenation) is what's absorbed.
+	tr1 := NewTranscript("framing")
+	tr1.Append("ab", []byte("cd"))
+	c1 := tr1.Challenge("c", 32)
+
+	tr2 := NewTranscript("framing")
+	tr2.Append("a", []byte("bcd"))
+	c2 := tr2.Challenge("c", 32)
+
+	if string(c1) == string(c2) {
+		t.Fatal("length framing did not distinguish differently-split names/data")
+	}
+}
+
+func TestChallengeDoesNotPerturbSubsequentBinds(t *testing.T) {
+	tr1 := NewTranscript("fork")
+	tr1.Append("x", []byte("1"))
+	_ = tr1.Challenge("c1", 32)
+	tr1.Append("y", []byte("2"))
+	out1 := tr1.Challenge("c2", 32)
+
+	// Challenge absorbs only its name before squeezing from a clone, so a
+	// transcript that absorbs the same name without drawing must match.
+	tr2 := NewTranscript("fork")
+	tr2.Append("x", []byte("1"))
+	tr2.Append("c1", nil)
+	tr2.Append("y", []byte("2"))
+	out2 := tr2.Challenge("c2", 32)
+
+	if string(out1) != string(out2) {
+		t.Fatal("drawing a challenge changed the transcript beyond absorbing its name")
+	}
+}